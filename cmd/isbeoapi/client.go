@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
+	"golang.org/x/tools/imports"
+)
+
+// The typed client is generated from the same OpenAPI documents as the routes.
+// Each resource (like ProductOffering) becomes a Go struct, together with the structs of the schemas
+// reachable from it. allOf compositions are flattened into a single struct, and the alternatives of
+// oneOf/anyOf are merged into one struct with the properties of all of them, discriminated by @type.
+// Schemas with the same name in several specs are merged.
+
+//go:embed models.hbs
+var modelsTemplate string
+
+//go:embed client.hbs
+var clientTemplate string
+
+// goField is a field of a generated struct
+type goField struct {
+	Name string
+	Type string
+	JSON string
+	Doc  string
+}
+
+// goType is a generated struct
+type goType struct {
+	Name     string
+	Doc      string
+	Fields   []*goField
+	Resource bool
+
+	fields map[string]*goField
+}
+
+// goResource is a resource with its typed operations
+type goResource struct {
+	APIFamily string
+	Name      string
+	Type      string
+	Plural    string
+	Create    bool
+	Get       bool
+	List      bool
+	Patch     bool
+	Delete    bool
+}
+
+type modelGenerator struct {
+	types map[string]*goType
+
+	// visiting holds the schemas being generated, to stop recursion in cyclic schemas
+	visiting map[string]bool
+}
+
+// generateClient writes the models and the typed client of the resources in the specs into dir
+func generateClient(specs *openapi.Registry, dir string) {
+	g := &modelGenerator{types: map[string]*goType{}, visiting: map[string]bool{}}
+
+	var resources []*goResource
+	for _, family := range specs.Families() {
+		spec, _ := specs.Spec(family)
+
+		names := make([]string, 0, len(spec.Resources))
+		for name := range spec.Resources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			res := spec.Resources[name]
+			if res.Schema == "" {
+				continue
+			}
+			typeName := g.schemaType(spec, res.Schema)
+			g.types[typeName].Resource = true
+			resources = append(resources, &goResource{
+				APIFamily: family,
+				Name:      name,
+				Type:      typeName,
+				Plural:    plural(typeName),
+				Create:    res.CreateSchema != "",
+				Get:       true,
+				List:      res.Listable,
+				Patch:     res.UpdateSchema != "",
+				Delete:    res.Deletable,
+			})
+		}
+	}
+
+	typeNames := make([]string, 0, len(g.types))
+	for name := range g.types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	types := make([]*goType, len(typeNames))
+	for i, name := range typeNames {
+		t := g.types[name]
+		sort.Slice(t.Fields, func(i, j int) bool { return t.Fields[i].JSON < t.Fields[j].JSON })
+		types[i] = t
+	}
+
+	writeGoFile(filepath.Join(dir, "models_gen.go"), modelsTemplate, map[string]any{"Types": types})
+	writeGoFile(filepath.Join(dir, "client_gen.go"), clientTemplate, map[string]any{"Resources": resources})
+}
+
+// writeGoFile executes a template and writes the formatted result
+func writeGoFile(fileName string, text string, data any) {
+	tmpl, err := template.New(filepath.Base(fileName)).Funcs(template.FuncMap{
+		"lowerFirst": lowerFirst,
+	}).Parse(text)
+	if err != nil {
+		panic(err)
+	}
+
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		panic(err)
+	}
+
+	out, err := imports.Process(fileName, b.Bytes(), nil)
+	if err != nil {
+		panic(fmt.Errorf("formatting %s: %w", fileName, err))
+	}
+
+	if err := os.WriteFile(fileName, out, 0644); err != nil {
+		panic(err)
+	}
+}
+
+// schemaType generates the struct of a schema in components/schemas, and returns its name
+func (g *modelGenerator) schemaType(spec *openapi.Spec, ref string) string {
+	schemaName := ref[strings.LastIndex(ref, "/")+1:]
+	name := goName(schemaName)
+
+	t := g.types[name]
+	if t == nil {
+		t = &goType{Name: name, fields: map[string]*goField{}}
+		g.types[name] = t
+	}
+
+	key := spec.APIFamily + ref
+	if g.visiting[key] {
+		return name
+	}
+	g.visiting[key] = true
+
+	schema, err := spec.Resolve(ref)
+	if err != nil {
+		panic(err)
+	}
+	if t.Doc == "" {
+		t.Doc = description(schema)
+	}
+	g.collect(spec, t, schema, 0)
+
+	return name
+}
+
+// collect adds to t the properties of a schema, including those of its compositions
+func (g *modelGenerator) collect(spec *openapi.Spec, t *goType, schema map[string]any, depth int) {
+	if depth > 32 {
+		return
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		target, err := spec.Resolve(ref)
+		if err != nil {
+			panic(err)
+		}
+		g.collect(spec, t, target, depth+1)
+		return
+	}
+
+	for _, key := range []string{"allOf", "oneOf", "anyOf"} {
+		list, _ := schema[key].([]any)
+		for _, item := range list {
+			if m, ok := item.(map[string]any); ok {
+				g.collect(spec, t, m, depth+1)
+			}
+		}
+	}
+
+	props, _ := schema["properties"].(map[string]any)
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, jsonName := range names {
+		prop, _ := props[jsonName].(map[string]any)
+		fieldType := g.fieldType(spec, prop)
+
+		if f, ok := t.fields[jsonName]; ok {
+			// The same property with different types in the alternatives of a union
+			if f.Type != fieldType {
+				f.Type = "any"
+			}
+			continue
+		}
+
+		f := &goField{Name: fieldName(jsonName), Type: fieldType, JSON: jsonName, Doc: description(prop)}
+		for _, other := range t.Fields {
+			if other.Name == f.Name {
+				f.Name += "_"
+			}
+		}
+		t.fields[jsonName] = f
+		t.Fields = append(t.Fields, f)
+	}
+}
+
+// fieldType returns the Go type of a property
+func (g *modelGenerator) fieldType(spec *openapi.Spec, prop map[string]any) string {
+	if ref, ok := prop["$ref"].(string); ok {
+		target, err := spec.Resolve(ref)
+		if err != nil {
+			panic(err)
+		}
+		if isStruct(target) {
+			return "*" + g.schemaType(spec, ref)
+		}
+		return g.fieldType(spec, target)
+	}
+
+	switch prop["type"] {
+	case "string":
+		if prop["format"] == "date-time" {
+			return "*time.Time"
+		}
+		return "string"
+	case "boolean":
+		return "*bool"
+	case "integer":
+		return "*int64"
+	case "number":
+		return "*float64"
+	case "array":
+		items, _ := prop["items"].(map[string]any)
+		if items == nil {
+			return "[]any"
+		}
+		return "[]" + strings.TrimPrefix(g.fieldType(spec, items), "*")
+	case "object":
+		// Inline objects are not named, so they are kept untyped
+		return "map[string]any"
+	}
+	if isStruct(prop) {
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// isStruct returns true if a schema is an object with properties, or a composition of them
+func isStruct(schema map[string]any) bool {
+	for _, key := range []string{"properties", "allOf", "oneOf", "anyOf"} {
+		if _, ok := schema[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// description returns the first sentence of the description of a schema or of its compositions
+func description(schema map[string]any) string {
+	d, _ := schema["description"].(string)
+	if d == "" {
+		list, _ := schema["allOf"].([]any)
+		for _, item := range list {
+			if m, ok := item.(map[string]any); ok {
+				if d, _ = m["description"].(string); d != "" {
+					break
+				}
+			}
+		}
+	}
+	d = strings.Join(strings.Fields(d), " ")
+	for i := 0; i < len(d); {
+		j := strings.Index(d[i:], ". ")
+		if j < 0 {
+			break
+		}
+		end := i + j + 1
+		if !strings.HasSuffix(d[:end], "e.g.") && !strings.HasSuffix(d[:end], "i.e.") {
+			return d[:end]
+		}
+		i = end
+	}
+	return d
+}
+
+// commonInitialisms are written in upper case in Go names
+var commonInitialisms = map[string]string{
+	"id": "ID", "url": "URL", "uri": "URI", "href": "Href",
+}
+
+// fieldName returns the Go name of a property, like "AtType" for "@type" or "ID" for "id"
+func fieldName(jsonName string) string {
+	prefix := ""
+	if strings.HasPrefix(jsonName, "@") {
+		prefix = "At"
+		jsonName = jsonName[1:]
+	}
+	if s, ok := commonInitialisms[jsonName]; ok {
+		return prefix + s
+	}
+	return prefix + goName(jsonName)
+}
+
+// goName returns an exported Go identifier for a name, removing characters not allowed
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "X" + s
+	}
+	return s
+}
+
+// plural returns the plural of a type name, used for the list operations
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ey"):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/goccy/go-yaml"
 	"github.com/hesusruiz/isbetmf/internal/jpath"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
 	"golang.org/x/tools/imports"
 )
 
@@ -18,6 +19,7 @@ import (
 // and extract the mapping of last path part to management system and the routes.
 // It assumes the Swagger files are in the format used by the TMForum APIs.
 // It will print the mapping and the routes to the standard output in JSON format.
+// It also generates the typed models and client of the resources in the tmfclient package.
 
 //go:embed routes.hbs
 var routesTemplate string
@@ -74,6 +76,13 @@ func main() {
 		panic(err)
 	}
 
+	// Generate the typed models and client of the resources
+	specs, err := openapi.LoadDir(baseDir)
+	if err != nil {
+		panic(err)
+	}
+	generateClient(specs, "./tmfclient")
+
 }
 
 func processOneFile(filePath string, managementToUpstream map[string]string, resourceToManagement map[string]string, resourceToPath map[string]string) {
@@ -139,11 +148,6 @@ func processOneFile(filePath string, managementToUpstream map[string]string, res
 			resourceName = pathParts[len(pathParts)-2]
 		}
 
-		if firstPart == "importJob" || firstPart == "exportJob" {
-			// We do not implement these APIs
-			continue
-		}
-
 		if firstPart == "hub" || firstPart == "listener" {
 			// TODO: implement specia processing for these paths
 			continue
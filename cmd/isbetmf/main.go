@@ -1,14 +1,24 @@
 package main
 
 import (
+	"errors"
 	"flag" // Added
+	"fmt"
+	"io/fs"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
 
 	"log/slog"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/hesusruiz/isbetmf/pdp"
 	fiberhandler "github.com/hesusruiz/isbetmf/tmfserver/handler/fiber"
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
 	repository "github.com/hesusruiz/isbetmf/tmfserver/repository"
 	service "github.com/hesusruiz/isbetmf/tmfserver/service"
 	"github.com/jmoiron/sqlx"
@@ -20,8 +30,33 @@ func main() {
 	// Configure slog logger
 	var debugFlag bool
 	var verifierServer string
+	var callbackAllow, callbackDeny, callbackSchemes string
+	var callbackVerify bool
+	var sinks, globalSinks, sinkSubscribers string
+	var suspendAfter int
+	var metricsToken string
+	var openapiDir, schemaValidation string
+	var jobsDir string
+	var refIntegrity string
+	var lifecycle string
+	var objectValidation string
 	flag.BoolVar(&debugFlag, "d", false, "Enable debug logging")
 	flag.StringVar(&verifierServer, "verifier", "", "Full URL of the verifier which signs access tokens")
+	flag.StringVar(&callbackAllow, "callback-allow", os.Getenv("ISBETMF_CALLBACK_ALLOW"), "Comma-separated hosts, IPs or CIDRs allowed as hub callbacks (eg. 127.0.0.0/8 to use the /listener mock at http://127.0.0.1:9991, denied by default)")
+	flag.StringVar(&callbackDeny, "callback-deny", os.Getenv("ISBETMF_CALLBACK_DENY"), "Comma-separated hosts, IPs or CIDRs denied as hub callbacks, in addition to internal addresses")
+	flag.StringVar(&callbackSchemes, "callback-schemes", envString("ISBETMF_CALLBACK_SCHEMES", "http,https"), "Comma-separated URL schemes allowed for hub callbacks")
+	flag.BoolVar(&callbackVerify, "callback-verify", os.Getenv("ISBETMF_CALLBACK_VERIFY") == "true", "Require a challenge handshake before activating hub subscriptions")
+	flag.StringVar(&sinks, "sinks", os.Getenv("ISBETMF_SINKS"), "Semicolon-separated list of name=url event sinks (nats://, kafka://, amqp://, file://), used with callbacks like sink://name")
+	flag.StringVar(&globalSinks, "global-sinks", os.Getenv("ISBETMF_GLOBAL_SINKS"), "Comma-separated names of the sinks which receive all events")
+	flag.StringVar(&sinkSubscribers, "sink-subscribers", os.Getenv("ISBETMF_SINK_SUBSCRIBERS"), "Comma-separated organization identifiers allowed to subscribe with sink://name callbacks (none by default)")
+	flag.IntVar(&suspendAfter, "hub-suspend-after", envInt("ISBETMF_HUB_SUSPEND_AFTER", notifications.DefaultSuspendAfter), "Consecutive failed deliveries after which a hub subscription is suspended (0 to disable)")
+	flag.StringVar(&metricsToken, "metrics-token", os.Getenv("ISBETMF_METRICS_TOKEN"), "Bearer token required to read the delivery metrics in /metrics, which are disabled without it")
+	flag.StringVar(&openapiDir, "openapi-dir", envString("ISBETMF_OPENAPI_DIR", "./oapiv5"), "Directory with the TMForum OpenAPI v5 documents of the APIs implemented")
+	flag.StringVar(&schemaValidation, "schema-validation", envString("ISBETMF_SCHEMA_VALIDATION", "enforce"), "Validation of POST/PATCH bodies against the OpenAPI schemas: enforce, warn or off (the specs marked as subset only warn)")
+	flag.StringVar(&jobsDir, "jobs-dir", envString("ISBETMF_JOBS_DIR", "./jobs"), "Directory where import and export jobs write their files")
+	flag.StringVar(&refIntegrity, "ref-integrity", os.Getenv("ISBETMF_REF_INTEGRITY"), "Checks of the references between objects: off, warn or strict, optionally per referenced resource like warn,category=strict")
+	flag.StringVar(&lifecycle, "lifecycle", os.Getenv("ISBETMF_LIFECYCLE"), "State machines of the lifecycleStatus: empty for none, tmf620 for the TMF620 lifecycle of catalog entities, or the path of a JSON file")
+	flag.StringVar(&objectValidation, "object-validation", envString("ISBETMF_OBJECT_VALIDATION", "off"), "Validation of the objects written with the rules of the reporting tool: enforce, warn or off")
 	flag.Parse()
 
 	// Get the url of the verifier from command line (priority) or environment variable
@@ -75,17 +110,167 @@ func main() {
 	// Create the service
 	s := service.NewService(db, rulesEngine, verifierServer)
 
+	// Configure the rules for the callbacks of hub subscriptions
+	callbackPolicy := s.CallbackPolicy()
+	callbackPolicy.AllowedSchemes = splitList(callbackSchemes)
+	callbackPolicy.VerifyCallbacks = callbackVerify
+	callbackPolicy.SinkSubscribers = splitList(sinkSubscribers)
+	if err := callbackPolicy.AddRules(splitList(callbackAllow), splitList(callbackDeny)); err != nil {
+		slog.Error("invalid callback rules", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// Configure the message brokers and files where events can be delivered
+	if err := registerSinks(s.Notifications(), sinks, splitList(globalSinks)); err != nil {
+		slog.Error("invalid event sinks", slog.Any("error", err))
+		os.Exit(1)
+	}
+	s.Notifications().SetSuspendAfter(suspendAfter)
+	s.SetMetricsToken(metricsToken)
+
+	// Load the OpenAPI specs, which define the resources served and are used to validate the objects received
+	validationMode, err := openapi.ParseMode(schemaValidation)
+	if err != nil {
+		slog.Error("invalid schema validation mode", slog.Any("error", err))
+		os.Exit(1)
+	}
+	// Without the directory, the resources are routed with the mapping generated at build time and
+	// the bodies are not validated
+	specs, err := openapi.LoadDir(openapiDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		slog.Warn("OpenAPI directory not found, serving the resources generated at build time without schema validation", slog.String("dir", openapiDir))
+	} else if err != nil {
+		slog.Error("failed to load OpenAPI specs", slog.Any("error", err))
+		os.Exit(1)
+	} else {
+		s.SetOpenAPI(specs, validationMode)
+		var subsets []string
+		for _, family := range specs.Families() {
+			if spec, _ := specs.Spec(family); spec.Subset {
+				subsets = append(subsets, family)
+			}
+		}
+		slog.Info("OpenAPI specs loaded", slog.Any("apiFamilies", specs.Families()), slog.String("validation", string(validationMode)), slog.Any("subsetFamilies", subsets))
+	}
+
+	// Check the objects written with the same rules as the reporting tool, if configured
+	objectValidationMode, err := openapi.ParseMode(objectValidation)
+	if err != nil {
+		slog.Error("invalid object validation mode", slog.Any("error", err))
+		os.Exit(1)
+	}
+	s.SetValidator(service.NewServerValidator(), objectValidationMode)
+
+	// Check the references between objects, if configured
+	integrity, err := service.ParseIntegrityPolicy(refIntegrity)
+	if err != nil {
+		slog.Error("invalid reference integrity policy", slog.Any("error", err))
+		os.Exit(1)
+	}
+	s.SetIntegrity(integrity)
+
+	policy, err := service.LoadLifecyclePolicy(lifecycle)
+	if err != nil {
+		slog.Error("invalid lifecycle policy", slog.Any("error", err))
+		os.Exit(1)
+	}
+	s.SetLifecycle(policy)
+
+	// Import and export jobs write the exported objects and their logs in the jobs directory
+	if err := s.SetJobsDir(jobsDir); err != nil {
+		slog.Error("invalid jobs directory", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	app := fiber.New()
 
-	// Serve the OpenAPI UI
+	// Serve the OpenAPI UI, which shows the documents served by the server in /tmf-api
 	app.Static("/oapi", "./www/oapiui")
 
 	// Create handler and set the routes for the APIs
 	h := fiberhandler.NewHandler(s)
 	h.RegisterRoutes(app)
 
+	// Stop serving requests when the process is asked to terminate
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigChan
+		slog.Info("TMF API server shutting down", slog.String("signal", sig.String()))
+		if err := app.Shutdown(); err != nil {
+			slog.Error("failed to shut down the server", slog.Any("error", err))
+		}
+	}()
+
 	// And start the server
 	slog.Info("TMF API server starting", slog.String("port", ":9991"))
-	app.Listen("0.0.0.0:9991")
+	if err := app.Listen("0.0.0.0:9991"); err != nil {
+		slog.Error("failed to serve requests", slog.Any("error", err))
+	}
+
+	// Close the sinks, so the events buffered are written to the brokers and files
+	if err := s.Notifications().Close(); err != nil {
+		slog.Error("failed to close the event sinks", slog.Any("error", err))
+	}
+
+}
+
+// registerSinks creates the sinks in the spec, with the format "name1=url1;name2=url2", and registers them
+// in the notifications manager. The sinks whose name is in global receive all the events.
+func registerSinks(m *notifications.Manager, spec string, global []string) error {
+	registered := map[string]bool{}
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, rawURL, found := strings.Cut(entry, "=")
+		name, rawURL = strings.TrimSpace(name), strings.TrimSpace(rawURL)
+		if !found || name == "" || rawURL == "" {
+			return fmt.Errorf("invalid sink %q, expected name=url", entry)
+		}
+		sink, err := notifications.NewSinkFromURL(rawURL)
+		if err != nil {
+			return err
+		}
+		if err := m.RegisterSink(name, sink, slices.Contains(global, name)); err != nil {
+			return err
+		}
+		registered[name] = true
+		slog.Info("Event sink registered", slog.String("name", name), slog.Bool("global", slices.Contains(global, name)))
+	}
+	for _, name := range global {
+		if !registered[name] {
+			return fmt.Errorf("global sink %q is not defined", name)
+		}
+	}
+	return nil
+}
 
+// envString returns the value of an environment variable, or def if it is not set
+func envString(name string, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// envInt returns the integer value of an environment variable, or def if it is not set or invalid
+func envInt(name string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
+}
+
+// splitList splits a comma-separated list, trimming spaces and removing empty entries
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
 }
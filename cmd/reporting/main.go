@@ -7,9 +7,11 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 
+	"github.com/goccy/go-yaml"
 	"github.com/hesusruiz/isbetmf/reporting"
 )
 
@@ -21,13 +23,35 @@ func main() {
 		objectTypes          = flag.String("object-types", "", "Comma-separated list of object types to validate")
 		outputDir            = flag.String("output-dir", "./reports", "Output directory for reports")
 		reportFile           = flag.String("report-file", "tmf_validation_report.md", "Name of the report file")
+		reportFormats        = flag.String("formats", "markdown", "Comma-separated list of report formats: "+strings.Join(reporting.ReportFormats(), ", "))
 		paginationEnabled    = flag.Bool("pagination", true, "Enable pagination for object retrieval")
 		pageSize             = flag.Int("page-size", 100, "Number of objects per page")
 		maxObjects           = flag.Int("max-objects", 10000, "Maximum objects to retrieve per type")
+		concurrency          = flag.Int("concurrency", 4, "Maximum number of requests at the same time")
+		requestsPerSecond    = flag.Float64("rps", 10, "Maximum number of requests per second (0 for unlimited)")
+		maxRetries           = flag.Int("retries", 3, "Retries of the requests failing with a network error, 5xx or 429")
+		checkpointFile       = flag.String("checkpoint", "", "File to record the pages retrieved, to resume an interrupted run")
+		authMethod           = flag.String("auth", "", "Authentication method: bearer, client_credentials or vp")
+		tokenFile            = flag.String("token-file", "", "File with the bearer token, read in every request")
+		tokenURL             = flag.String("token-url", "", "Token endpoint of the OAuth2 server")
+		clientID             = flag.String("client-id", "", "Client identifier for client_credentials and vp")
+		verifierURL          = flag.String("verifier-url", "", "URL of the DOME verifier for vp")
+		credentialFile       = flag.String("credential-file", "", "File with the LEARCredentialMachine of the client for vp")
+		privateKeyFile       = flag.String("private-key-file", "", "PEM file with the private key of the client for vp")
 		validateRequired     = flag.Bool("validate-required", true, "Validate required fields")
 		validateRelatedParty = flag.Bool("validate-related-party", true, "Validate related party requirements")
 		progress             = flag.Bool("progress", false, "Show progress updates")
 		configFile           = flag.String("config", "", "Configuration file (JSON or YAML)")
+		rulesFile            = flag.String("rules", "", "Validation rules file (YAML)")
+		rulesDir             = flag.String("rules-dir", "", "Directory with validation rules written in Starlark")
+		printConfig          = flag.Bool("print-config", false, "Print the effective configuration and exit")
+		reportBySeller       = flag.Bool("by-seller", false, "Generate a report for each seller, with an index")
+		historyDB            = flag.String("history", "", "SQLite database where the reports are stored")
+		listRuns             = flag.Bool("list-runs", false, "List the runs stored in the history and exit")
+		diff                 = flag.Bool("diff", false, "Compare two runs stored in the history instead of validating")
+		diffFrom             = flag.String("from", "", "Run to compare from in diff mode (default the previous run)")
+		diffTo               = flag.String("to", "", "Run to compare to in diff mode (default the last run)")
+		trendRuns            = flag.Int("trend-runs", 10, "Number of runs included in the trends in diff mode")
 		help                 = flag.Bool("help", false, "Show help information")
 	)
 
@@ -38,46 +62,138 @@ func main() {
 		return
 	}
 
-	// Load configuration
+	// The configuration is taken from the defaults, the config file, the environment variables
+	// and the command line flags, each one overriding the previous ones
 	config := reporting.DefaultConfig()
 
 	// Load from config file if specified
 	if *configFile != "" {
-		if err := loadConfigFromFile(config, *configFile); err != nil {
+		if err := config.LoadConfigFromFile(*configFile); err != nil {
 			log.Fatalf("Failed to load config file: %v", err)
 		}
 	}
 
-	// Override with command line flags
-	if *baseURL != "" {
+	// Load from environment variables
+	config.LoadConfigFromEnv()
+
+	// Override with the command line flags which were set explicitly
+	setFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+
+	if setFlags["base-url"] {
 		config.BaseURL = *baseURL
 	}
-	if *timeout > 0 {
+	if setFlags["timeout"] {
 		config.Timeout = *timeout
 	}
-	if *objectTypes != "" {
+	if setFlags["object-types"] {
 		config.ObjectTypes = parseObjectTypes(*objectTypes)
 	}
-	if *outputDir != "" {
+	if setFlags["output-dir"] {
 		config.OutputDir = *outputDir
 	}
-	if *reportFile != "" {
+	if setFlags["report-file"] {
 		config.ReportFile = *reportFile
 	}
-	config.ValidateRequiredFields = *validateRequired
-	config.ValidateRelatedParty = *validateRelatedParty
-	config.PaginationEnabled = *paginationEnabled
-	config.PageSize = *pageSize
-	config.MaxObjects = *maxObjects
+	if setFlags["formats"] {
+		config.ReportFormats = parseObjectTypes(*reportFormats)
+	}
+	if setFlags["concurrency"] {
+		config.Concurrency = *concurrency
+	}
+	if setFlags["rps"] {
+		config.RequestsPerSecond = *requestsPerSecond
+	}
+	if setFlags["retries"] {
+		config.MaxRetries = *maxRetries
+	}
+	if setFlags["checkpoint"] {
+		config.CheckpointFile = *checkpointFile
+	}
+	if setFlags["auth"] {
+		config.Auth.Method = *authMethod
+	}
+	if setFlags["token-file"] {
+		config.Auth.TokenFile = *tokenFile
+	}
+	if setFlags["token-url"] {
+		config.Auth.TokenURL = *tokenURL
+	}
+	if setFlags["client-id"] {
+		config.Auth.ClientID = *clientID
+	}
+	if setFlags["verifier-url"] {
+		config.Auth.VerifierURL = *verifierURL
+	}
+	if setFlags["credential-file"] {
+		config.Auth.CredentialFile = *credentialFile
+	}
+	if setFlags["private-key-file"] {
+		config.Auth.PrivateKeyFile = *privateKeyFile
+	}
+	if setFlags["by-seller"] {
+		config.ReportBySeller = *reportBySeller
+	}
+	if setFlags["history"] {
+		config.HistoryDB = *historyDB
+	}
+	if setFlags["rules"] {
+		config.RulesFile = *rulesFile
+	}
+	if setFlags["rules-dir"] {
+		config.RulesDir = *rulesDir
+	}
+	if setFlags["validate-required"] {
+		config.ValidateRequiredFields = *validateRequired
+	}
+	if setFlags["validate-related-party"] {
+		config.ValidateRelatedParty = *validateRelatedParty
+	}
+	if setFlags["pagination"] {
+		config.PaginationEnabled = *paginationEnabled
+	}
+	if setFlags["page-size"] {
+		config.PageSize = *pageSize
+	}
+	if setFlags["max-objects"] {
+		config.MaxObjects = *maxObjects
+	}
 
-	// Load from environment variables
-	config.LoadConfigFromEnv()
+	if *printConfig {
+		out, err := yaml.Marshal(config.Redacted())
+		if err != nil {
+			log.Fatalf("Failed to print configuration: %v", err)
+		}
+		fmt.Print(string(out))
+		return
+	}
 
 	// Validate configuration
 	if err := config.Validate(); err != nil {
 		log.Fatalf("Configuration error: %v", err)
 	}
 
+	if *listRuns || *diff {
+		if config.HistoryDB == "" {
+			log.Fatalf("The history database must be specified with -history")
+		}
+		history, err := reporting.OpenHistory(config.HistoryDB)
+		if err != nil {
+			log.Fatalf("Failed to open history: %v", err)
+		}
+		defer history.Close()
+
+		if *listRuns {
+			err = printRuns(history, config.BaseURL)
+		} else {
+			err = writeDiff(history, config, *diffFrom, *diffTo, *trendRuns)
+		}
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
 	// Create proxy instance
 	proxyInstance, err := reporting.NewProxy(config)
 	if err != nil {
@@ -140,6 +256,64 @@ func runWithProgress(ctx context.Context, proxyInstance *reporting.Proxy) error
 	return nil
 }
 
+// printRuns prints the runs of a server stored in the history
+func printRuns(history *reporting.History, baseURL string) error {
+	runs, err := history.Runs(baseURL, 0)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%-36s  %-20s  %8s  %8s  %8s  %8s\n", "RUN", "GENERATED", "OBJECTS", "INVALID", "ERRORS", "WARNINGS")
+	for _, run := range runs {
+		fmt.Printf("%-36s  %-20s  %8d  %8d  %8d  %8d\n", run.RunID, run.GeneratedAt.Format("2006-01-02 15:04:05"),
+			run.TotalObjects, run.InvalidObjects, run.TotalErrors, run.TotalWarnings)
+	}
+	return nil
+}
+
+// writeDiff compares two runs of the history, by default the last two of the server, and writes the
+// comparison next to the report
+func writeDiff(history *reporting.History, config *reporting.Config, from, to string, trendRuns int) error {
+	if from == "" || to == "" {
+		runs, err := history.Runs(config.BaseURL, 0)
+		if err != nil {
+			return err
+		}
+		if to == "" && len(runs) > 0 {
+			to = runs[0].RunID
+		}
+		if from == "" {
+			for i, run := range runs {
+				if run.RunID == to && i+1 < len(runs) {
+					from = runs[i+1].RunID
+				}
+			}
+		}
+		if from == "" || to == "" {
+			return fmt.Errorf("at least two runs of %s are needed in the history to compare them", config.BaseURL)
+		}
+	}
+
+	diff, err := history.Diff(from, to, trendRuns)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(config.OutputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	name := strings.TrimSuffix(config.ReportFile, filepath.Ext(config.ReportFile)) + "_diff.md"
+	file, err := os.Create(filepath.Join(config.OutputDir, name))
+	if err != nil {
+		return fmt.Errorf("failed to create diff report: %w", err)
+	}
+	defer file.Close()
+	diff.WriteMarkdown(file)
+
+	log.Printf("Comparison of runs %s and %s: %d newly invalid objects, %d fixed objects", from, to, len(diff.NewlyInvalid), len(diff.Fixed))
+	log.Printf("Comparison saved to: %s", file.Name())
+	return nil
+}
+
 // parseObjectTypes parses a comma-separated string of object types
 func parseObjectTypes(typesStr string) []string {
 	if typesStr == "" {
@@ -153,13 +327,6 @@ func parseObjectTypes(typesStr string) []string {
 	return types
 }
 
-// loadConfigFromFile loads configuration from a file
-func loadConfigFromFile(config *reporting.Config, filename string) error {
-	// This is a placeholder - in a real implementation, you would
-	// parse JSON or YAML files here
-	return fmt.Errorf("config file loading not implemented yet")
-}
-
 // showHelp displays help information
 func showHelp() {
 	fmt.Printf(`TMForum Reporting Validator
@@ -177,12 +344,41 @@ Options:
         Output directory for reports (default "./reports")
   -report-file string
         Name of the report file (default "tmf_validation_report.md")
+  -formats string
+        Comma-separated list of report formats: markdown, json, html, csv, junit, sarif (default "markdown")
+        Each report is written with the name of the report file and the extension of the format
+  -by-seller
+        Generate a report for each seller in the subdirectory "sellers" of the output directory,
+        in the formats markdown, html and json selected, with an index
   -pagination
         Enable pagination for object retrieval (default true)
   -page-size int
         Number of objects per page (default 100)
   -max-objects int
         Maximum objects to retrieve per type (default 10000)
+  -concurrency int
+        Maximum number of requests at the same time, across object types and pages (default 4)
+  -rps float
+        Maximum number of requests per second, 0 for unlimited (default 10)
+  -retries int
+        Retries of the requests failing with a network error, 5xx or 429, with exponential backoff (default 3)
+  -checkpoint string
+        File to record the pages retrieved. If the run is interrupted, running it again with the
+        same file resumes it without retrieving those pages again. Deleted when the run completes
+  -auth string
+        Authentication method of the requests: bearer, client_credentials or vp (default none)
+  -token-file string
+        File with the bearer token, read in every request so it can be renewed by another process
+  -token-url string
+        Token endpoint of the OAuth2 server, for client_credentials and vp
+  -client-id string
+        Client identifier, for client_credentials and vp (the DID of the organization for vp)
+  -verifier-url string
+        URL of the DOME verifier, where the token endpoint is discovered, for vp
+  -credential-file string
+        File with the LEARCredentialMachine of the client, as a JWT, for vp
+  -private-key-file string
+        PEM file with the private key of the client, which signs the presentation, for vp
   -validate-required
         Validate required fields (default true)
   -validate-related-party
@@ -191,23 +387,71 @@ Options:
         Show progress updates
   -config string
         Configuration file (JSON or YAML)
+  -print-config
+        Print the effective configuration and exit, with the secrets redacted
+  -rules string
+        Validation rules file (YAML), see reporting/rules.example.yaml
+  -rules-dir string
+        Directory with validation rules written in Starlark, see reporting/example_rules
+  -history string
+        SQLite database where the reports are stored, to compare them over time
+  -list-runs
+        List the runs of the server stored in the history and exit
+  -diff
+        Compare two runs of the server stored in the history instead of validating.
+        The comparison is written to the output directory, with the name of the report file and "_diff.md"
+  -from string
+        Run to compare from in diff mode (default the run before the one compared to)
+  -to string
+        Run to compare to in diff mode (default the last run)
+  -trend-runs int
+        Number of runs included in the trends in diff mode (default 10)
   -help
         Show this help information
 
+The bearer token and the client secret are not accepted as flags, to keep them out of the
+process list: set them in the environment or in the configuration file.
+
+The configuration file overrides the defaults, the environment variables override
+the configuration file, and the flags set in the command line override both.
+
 Environment Variables:
   TMF_BASE_URL          Base URL of the TMForum server
   TMF_TIMEOUT           Timeout in seconds
   TMF_OBJECT_TYPES      Comma-separated list of object types
   TMF_OUTPUT_DIR        Output directory
   TMF_REPORT_FILE       Report file name
+  TMF_REPORT_FORMATS    Comma-separated list of report formats
   TMF_PAGINATION_ENABLED Enable pagination (true/false)
   TMF_PAGE_SIZE         Number of objects per page
   TMF_MAX_OBJECTS       Maximum objects to retrieve per type
+  TMF_REPORT_BY_SELLER  Generate a report for each seller (true/false)
+  TMF_HISTORY_DB        SQLite database where the reports are stored
+  TMF_CONCURRENCY       Maximum number of requests at the same time
+  TMF_REQUESTS_PER_SECOND Maximum number of requests per second
+  TMF_MAX_RETRIES       Retries of the failed requests
+  TMF_CHECKPOINT_FILE   File to record the pages retrieved, to resume the run
+  TMF_AUTH_METHOD       Authentication method (bearer, client_credentials, vp)
+  TMF_AUTH_TOKEN        Bearer token
+  TMF_AUTH_TOKEN_FILE   File with the bearer token
+  TMF_AUTH_TOKEN_URL    Token endpoint of the OAuth2 server
+  TMF_AUTH_CLIENT_ID    Client identifier
+  TMF_AUTH_CLIENT_SECRET Client secret for client_credentials
+  TMF_AUTH_SCOPE        Scope of the access tokens requested
+  TMF_AUTH_VERIFIER_URL URL of the DOME verifier
+  TMF_AUTH_CREDENTIAL_FILE File with the LEARCredentialMachine of the client
+  TMF_AUTH_PRIVATE_KEY_FILE PEM file with the private key of the client
+  TMF_RULES_FILE        Validation rules file
+  TMF_RULES_DIR         Directory with validation rules written in Starlark
 
 Examples:
   tmfproxy -base-url "https://tmf.example.com" -object-types "productOffering,productSpecification"
   tmfproxy -config config.yaml
+  tmfproxy -config config.yaml -formats "markdown,junit,sarif"
+  tmfproxy -config config.yaml -history history.db
+  tmfproxy -config config.yaml -history history.db -diff
   TMF_BASE_URL="https://tmf.example.com" tmfproxy
+  TMF_AUTH_TOKEN="eyJ..." tmfproxy -config config.yaml -auth bearer
 
 `)
 }
@@ -5,26 +5,66 @@
 package config
 
 var GeneratedISBEManagementToUpstream = map[string]string{
-	"partyManagement":          "TODO: set upstream host and path like http://localhost:8620",
-	"productCatalogManagement": "http://localhost:8620",
+	"agreementManagement":       "http://localhost:8620",
+	"partyManagement":           "TODO: set upstream host and path like http://localhost:8620",
+	"productCatalogManagement":  "http://localhost:8620",
+	"productInventory":          "http://localhost:8620",
+	"productOrderingManagement": "http://localhost:8620",
+	"resourceCatalog":           "http://localhost:8620",
+	"serviceCatalogManagement":  "http://localhost:8620",
+	"usageManagement":           "http://localhost:8620",
 }
 
 var GeneratedISBEResourceToManagement = map[string]string{
-	"category":             "productCatalogManagement",
-	"individual":           "partyManagement",
-	"organization":         "partyManagement",
-	"productCatalog":       "productCatalogManagement",
-	"productOffering":      "productCatalogManagement",
-	"productOfferingPrice": "productCatalogManagement",
-	"productSpecification": "productCatalogManagement",
+	"agreement":              "agreementManagement",
+	"agreementSpecification": "agreementManagement",
+	"cancelProductOrder":     "productOrderingManagement",
+	"category":               "productCatalogManagement",
+	"exportJob":              "productCatalogManagement",
+	"importJob":              "productCatalogManagement",
+	"individual":             "partyManagement",
+	"organization":           "partyManagement",
+	"product":                "productInventory",
+	"productCatalog":         "productCatalogManagement",
+	"productOffering":        "productCatalogManagement",
+	"productOfferingPrice":   "productCatalogManagement",
+	"productOrder":           "productOrderingManagement",
+	"productSpecification":   "productCatalogManagement",
+	"resourceCandidate":      "resourceCatalog",
+	"resourceCatalog":        "resourceCatalog",
+	"resourceCategory":       "resourceCatalog",
+	"resourceSpecification":  "resourceCatalog",
+	"serviceCandidate":       "serviceCatalogManagement",
+	"serviceCatalog":         "serviceCatalogManagement",
+	"serviceCategory":        "serviceCatalogManagement",
+	"serviceSpecification":   "serviceCatalogManagement",
+	"usage":                  "usageManagement",
+	"usageSpecification":     "usageManagement",
 }
 
 var GeneratedISBEResourceToPathPrefix = map[string]string{
-	"category":             "/tmf-api/productCatalogManagement/v5/category",
-	"individual":           "/tmf-api/partyManagement/v5/individual",
-	"organization":         "/tmf-api/partyManagement/v5/organization",
-	"productCatalog":       "/tmf-api/productCatalogManagement/v5/productCatalog",
-	"productOffering":      "/tmf-api/productCatalogManagement/v5/productOffering",
-	"productOfferingPrice": "/tmf-api/productCatalogManagement/v5/productOfferingPrice",
-	"productSpecification": "/tmf-api/productCatalogManagement/v5/productSpecification",
+	"agreement":              "/tmf-api/agreementManagement/v5/agreement",
+	"agreementSpecification": "/tmf-api/agreementManagement/v5/agreementSpecification",
+	"cancelProductOrder":     "/tmf-api/productOrderingManagement/v5/cancelProductOrder",
+	"category":               "/tmf-api/productCatalogManagement/v5/category",
+	"exportJob":              "/tmf-api/productCatalogManagement/v5/exportJob",
+	"importJob":              "/tmf-api/productCatalogManagement/v5/importJob",
+	"individual":             "/tmf-api/partyManagement/v5/individual",
+	"organization":           "/tmf-api/partyManagement/v5/organization",
+	"product":                "/tmf-api/productInventory/v5/product",
+	"productCatalog":         "/tmf-api/productCatalogManagement/v5/productCatalog",
+	"productOffering":        "/tmf-api/productCatalogManagement/v5/productOffering",
+	"productOfferingPrice":   "/tmf-api/productCatalogManagement/v5/productOfferingPrice",
+	"productOrder":           "/tmf-api/productOrderingManagement/v5/productOrder",
+	"productSpecification":   "/tmf-api/productCatalogManagement/v5/productSpecification",
+	"resourceCandidate":      "/tmf-api/resourceCatalog/v5/resourceCandidate",
+	"resourceCatalog":        "/tmf-api/resourceCatalog/v5/resourceCatalog",
+	"resourceCategory":       "/tmf-api/resourceCatalog/v5/resourceCategory",
+	"resourceSpecification":  "/tmf-api/resourceCatalog/v5/resourceSpecification",
+	"serviceCandidate":       "/tmf-api/serviceCatalogManagement/v5/serviceCandidate",
+	"serviceCatalog":         "/tmf-api/serviceCatalogManagement/v5/serviceCatalog",
+	"serviceCategory":        "/tmf-api/serviceCatalogManagement/v5/serviceCategory",
+	"serviceSpecification":   "/tmf-api/serviceCatalogManagement/v5/serviceSpecification",
+	"usage":                  "/tmf-api/usageManagement/v5/usage",
+	"usageSpecification":     "/tmf-api/usageManagement/v5/usageSpecification",
 }
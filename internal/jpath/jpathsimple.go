@@ -273,6 +273,10 @@ func Get(src any, path string) (any, error) {
 					strings.Join(parts[:pos+1], "."))
 			}
 
+		case nil:
+			return nil, fmt.Errorf("jpath.Get: null value at %q",
+				strings.Join(parts[:pos], "."))
+
 		default:
 
 			// For other types, use reflection
@@ -173,3 +173,22 @@ func (s StarTMFList) Index(i int) st.Value {
 	value := s[i]
 	return anyToValue(value)
 }
+
+// Iterable interface, so the lists can be used in for loops
+func (s StarTMFList) Iterate() st.Iterator { return &starTMFListIterator{list: s} }
+
+type starTMFListIterator struct {
+	list  StarTMFList
+	index int
+}
+
+func (it *starTMFListIterator) Next(p *st.Value) bool {
+	if it.index >= len(it.list) {
+		return false
+	}
+	*p = it.list[it.index]
+	it.index++
+	return true
+}
+
+func (it *starTMFListIterator) Done() {}
@@ -0,0 +1,359 @@
+package reporting
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// clientAssertionType is the type of the client assertions sent in the vp method
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// tokenExpiryMargin is the time before the expiration of an access token when it is renewed
+const tokenExpiryMargin = 30 * time.Second
+
+// TokenSource provides the access tokens sent to the server in the Authorization header
+type TokenSource interface {
+	// Token returns a valid access token
+	Token(ctx context.Context) (string, error)
+
+	// Invalidate discards the token, when it is rejected by the server, so a new one is obtained.
+	// It has no effect if the token was already replaced. It returns false if the source can not
+	// provide another token, so the request is not retried.
+	Invalidate(token string) bool
+}
+
+// NewTokenSource returns the source of access tokens for the authentication method configured,
+// or nil if the requests are not authenticated
+func NewTokenSource(auth AuthConfig, httpClient *http.Client) (TokenSource, error) {
+	switch auth.Method {
+	case AuthNone:
+		return nil, nil
+	case AuthBearer:
+		return &staticTokenSource{token: auth.Token, file: auth.TokenFile}, nil
+	case AuthClientCredentials:
+		return &oauthTokenSource{
+			httpClient: httpClient,
+			tokenURL:   auth.TokenURL,
+			form: func(string) (url.Values, error) {
+				form := url.Values{
+					"grant_type":    {"client_credentials"},
+					"client_id":     {auth.ClientID},
+					"client_secret": {auth.ClientSecret},
+				}
+				if auth.Scope != "" {
+					form.Set("scope", auth.Scope)
+				}
+				return form, nil
+			},
+		}, nil
+	case AuthVP:
+		presenter, err := newVPPresenter(auth)
+		if err != nil {
+			return nil, err
+		}
+		return &oauthTokenSource{
+			httpClient:  httpClient,
+			tokenURL:    auth.TokenURL,
+			verifierURL: strings.TrimRight(auth.VerifierURL, "/"),
+			form:        presenter.form,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown authentication method %q", auth.Method)
+	}
+}
+
+// staticTokenSource returns a token configured, or read from a file in every request
+type staticTokenSource struct {
+	token string
+	file  string
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (string, error) {
+	if s.file == "" {
+		return s.token, nil
+	}
+	content, err := os.ReadFile(s.file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// Invalidate returns false, as the token configured is not renewed by the client
+func (s *staticTokenSource) Invalidate(token string) bool {
+	return false
+}
+
+// oauthTokenSource obtains access tokens from the token endpoint of an OAuth2 server with the client
+// credentials grant, and keeps them until they expire
+type oauthTokenSource struct {
+	httpClient  *http.Client
+	tokenURL    string
+	verifierURL string
+
+	// form returns the parameters of the token request for the audience (the issuer of the tokens)
+	form func(audience string) (url.Values, error)
+
+	mu     sync.Mutex
+	issuer string
+	token  string
+	expiry time.Time
+}
+
+// tokenResponse is the response of the token endpoint
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (s *oauthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	if err := s.discover(ctx); err != nil {
+		return "", err
+	}
+	audience := s.issuer
+	if audience == "" {
+		audience = s.tokenURL
+	}
+	form, err := s.form(audience)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint did not return an access token")
+	}
+
+	s.token = token.AccessToken
+	s.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpiryMargin)
+	}
+	return s.token, nil
+}
+
+func (s *oauthTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = ""
+	}
+	return true
+}
+
+// discover retrieves the token endpoint and the issuer from the OpenID configuration of the verifier,
+// if they are not known yet
+func (s *oauthTokenSource) discover(ctx context.Context) error {
+	if s.verifierURL == "" || (s.tokenURL != "" && s.issuer != "") {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", s.verifierURL+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve OpenID configuration of the verifier: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("verifier returned status %d for the OpenID configuration", resp.StatusCode)
+	}
+
+	var oid struct {
+		Issuer        string `json:"issuer"`
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&oid); err != nil {
+		return fmt.Errorf("failed to parse OpenID configuration of the verifier: %w", err)
+	}
+	if s.tokenURL == "" {
+		if oid.TokenEndpoint == "" {
+			return fmt.Errorf("OpenID configuration of the verifier without token_endpoint")
+		}
+		s.tokenURL = oid.TokenEndpoint
+	}
+	s.issuer = oid.Issuer
+	if s.issuer == "" {
+		s.issuer = s.verifierURL
+	}
+	return nil
+}
+
+// vpPresenter creates the client assertions of the DOME machine-to-machine login, which carry
+// a Verifiable Presentation of the LEARCredentialMachine of the client
+type vpPresenter struct {
+	clientID   string
+	scope      string
+	credential string
+	key        crypto.Signer
+	method     jwt.SigningMethod
+}
+
+// newVPPresenter loads the credential and the private key of the holder
+func newVPPresenter(auth AuthConfig) (*vpPresenter, error) {
+	credential, err := os.ReadFile(auth.CredentialFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential file: %w", err)
+	}
+	key, method, err := loadPrivateKey(auth.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &vpPresenter{
+		clientID:   auth.ClientID,
+		scope:      auth.Scope,
+		credential: strings.TrimSpace(string(credential)),
+		key:        key,
+		method:     method,
+	}, nil
+}
+
+// loadPrivateKey reads a private key in PEM format, PKCS#8 or EC, with the algorithm to sign with it
+func loadPrivateKey(path string) (crypto.Signer, jwt.SigningMethod, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM data in private key file %s", path)
+	}
+
+	var key any
+	if block.Type == "EC PRIVATE KEY" {
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid private key in %s: %w", path, err)
+	}
+
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return k, jwt.SigningMethodES256, nil
+		case 384:
+			return k, jwt.SigningMethodES384, nil
+		}
+		return nil, nil, fmt.Errorf("unsupported curve %s in %s", k.Curve.Params().Name, path)
+	case *rsa.PrivateKey:
+		return k, jwt.SigningMethodRS256, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported private key type %T in %s", key, path)
+	}
+}
+
+// form returns the parameters of the token request, with a new client assertion for the audience
+func (p *vpPresenter) form(audience string) (url.Values, error) {
+	now := time.Now()
+
+	// The Verifiable Presentation of the credential, signed by the holder
+	vpID := uuid.NewString()
+	vp, err := p.sign(jwt.MapClaims{
+		"iss": p.clientID,
+		"sub": p.clientID,
+		"aud": audience,
+		"iat": now.Unix(),
+		"nbf": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+		"jti": vpID,
+		"vp": map[string]any{
+			"@context":             []string{"https://www.w3.org/ns/credentials/v2"},
+			"id":                   vpID,
+			"type":                 []string{"VerifiablePresentation"},
+			"holder":               p.clientID,
+			"verifiableCredential": []string{p.credential},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The client assertion authenticating the client, which carries the presentation
+	assertion, err := p.sign(jwt.MapClaims{
+		"iss":      p.clientID,
+		"sub":      p.clientID,
+		"aud":      audience,
+		"iat":      now.Unix(),
+		"exp":      now.Add(5 * time.Minute).Unix(),
+		"jti":      uuid.NewString(),
+		"vp_token": base64.RawURLEncoding.EncodeToString([]byte(vp)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {p.clientID},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+	}
+	if p.scope != "" {
+		form.Set("scope", p.scope)
+	}
+	return form, nil
+}
+
+// sign returns a JWT with the claims signed with the key of the holder
+func (p *vpPresenter) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(p.method, claims)
+	token.Header["kid"] = p.clientID
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign presentation: %w", err)
+	}
+	return signed, nil
+}
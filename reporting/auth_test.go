@@ -0,0 +1,400 @@
+package reporting
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// objectsHandler serves n productOffering objects with pagination
+func objectsHandler(n int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		objects := []map[string]any{}
+		for i := offset; i < min(offset+limit, n); i++ {
+			objects = append(objects, map[string]any{"id": fmt.Sprintf("po-%03d", i)})
+		}
+		json.NewEncoder(w).Encode(objects)
+	})
+}
+
+// authClient returns a client of the server authenticated with the method configured
+func authClient(t *testing.T, serverURL string, auth AuthConfig) (*Client, *Config) {
+	t.Helper()
+	config := DefaultConfig()
+	config.BaseURL = serverURL
+	config.PageSize = 10
+	config.RequestsPerSecond = 0
+	config.Auth = auth
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(config)
+	tokens, err := NewTokenSource(auth, client.httpClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTokenSource(tokens)
+	return client, config
+}
+
+func TestBearerAuth(t *testing.T) {
+	var rejected atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+r.URL.Query().Get("token") {
+			rejected.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer ts.Close()
+
+	client, _ := authClient(t, ts.URL, AuthConfig{Method: AuthBearer, Token: "secret"})
+	if _, err := client.get(context.Background(), ts.URL+"?token=secret"); err != nil {
+		t.Errorf("Unexpected error with the token: %v", err)
+	}
+
+	// The token can not be renewed, so it is not sent again
+	if _, err := client.get(context.Background(), ts.URL+"?token=other"); err == nil {
+		t.Error("Expected error with a rejected token")
+	}
+	if rejected.Load() != 1 {
+		t.Errorf("Expected 1 request with the rejected token, got %d", rejected.Load())
+	}
+
+	// The token file is read in every request
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	os.WriteFile(tokenFile, []byte("first\n"), 0600)
+	client, _ = authClient(t, ts.URL, AuthConfig{Method: AuthBearer, TokenFile: tokenFile})
+	if _, err := client.get(context.Background(), ts.URL+"?token=first"); err != nil {
+		t.Errorf("Unexpected error with the token file: %v", err)
+	}
+	os.WriteFile(tokenFile, []byte("second"), 0600)
+	if _, err := client.get(context.Background(), ts.URL+"?token=second"); err != nil {
+		t.Errorf("Unexpected error with the renewed token file: %v", err)
+	}
+}
+
+func TestClientCredentialsAuth(t *testing.T) {
+	verifier := newMockVerifier()
+	defer verifier.Close()
+	verifier.AddClient("reporting", "s3cret")
+	ts := httptest.NewServer(verifier.Protect(objectsHandler(35)))
+	defer ts.Close()
+
+	auth := AuthConfig{Method: AuthClientCredentials, TokenURL: verifier.URL + "/oidc/token", ClientID: "reporting", ClientSecret: "s3cret"}
+	client, config := authClient(t, ts.URL, auth)
+
+	// The token is requested once for all the pages
+	objects, err := client.GetObjects(context.Background(), "productOffering", config)
+	if err != nil || len(objects) != 35 {
+		t.Fatalf("Expected 35 objects, got %d: %v", len(objects), err)
+	}
+	if verifier.TokenRequests() != 1 {
+		t.Errorf("Expected 1 token request, got %d", verifier.TokenRequests())
+	}
+
+	// A token rejected by the server is renewed
+	verifier.RevokeAll()
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err != nil {
+		t.Fatalf("Unexpected error after revoking the token: %v", err)
+	}
+	if verifier.TokenRequests() != 2 {
+		t.Errorf("Expected a new token after revoking it, got %d token requests", verifier.TokenRequests())
+	}
+
+	// An expired token is renewed before the request
+	source := client.tokens.(*oauthTokenSource)
+	source.expiry = time.Now().Add(-time.Second)
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err != nil {
+		t.Fatal(err)
+	}
+	if verifier.TokenRequests() != 3 {
+		t.Errorf("Expected a new token after it expired, got %d token requests", verifier.TokenRequests())
+	}
+
+	auth.ClientSecret = "wrong"
+	client, config = authClient(t, ts.URL, auth)
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Errorf("Expected error with a wrong secret, got %v", err)
+	}
+}
+
+func TestVerifiablePresentationAuth(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "private_key.pem")
+	credentialFile := filepath.Join(dir, "credential.jwt")
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+	os.WriteFile(credentialFile, []byte("eyJhbGciOiJFUzI1NiJ9.eyJ2YyI6e319.c2ln\n"), 0600)
+
+	verifier := newMockVerifier()
+	defer verifier.Close()
+	verifier.ExpiresIn = 3600
+	verifier.AddHolder("did:elsi:VATES-12345678", &key.PublicKey)
+	ts := httptest.NewServer(verifier.Protect(objectsHandler(15)))
+	defer ts.Close()
+
+	// The token endpoint is discovered from the verifier
+	auth := AuthConfig{
+		Method:         AuthVP,
+		VerifierURL:    verifier.URL + "/",
+		ClientID:       "did:elsi:VATES-12345678",
+		CredentialFile: credentialFile,
+		PrivateKeyFile: keyFile,
+	}
+	client, config := authClient(t, ts.URL, auth)
+	objects, err := client.GetObjects(context.Background(), "productOffering", config)
+	if err != nil || len(objects) != 15 {
+		t.Fatalf("Expected 15 objects, got %d: %v", len(objects), err)
+	}
+	if verifier.TokenRequests() != 1 {
+		t.Errorf("Expected 1 token request, got %d", verifier.TokenRequests())
+	}
+
+	// A presentation signed with another key is rejected
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	verifier.AddHolder("did:elsi:VATES-12345678", &otherKey.PublicKey)
+	client, config = authClient(t, ts.URL, auth)
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err == nil {
+		t.Error("Expected error with a presentation signed with another key")
+	}
+}
+
+func TestAuthConfig(t *testing.T) {
+	testCases := []struct {
+		auth  AuthConfig
+		valid bool
+	}{
+		{AuthConfig{}, true},
+		{AuthConfig{Method: AuthBearer}, false},
+		{AuthConfig{Method: AuthBearer, TokenFile: "token"}, true},
+		{AuthConfig{Method: AuthClientCredentials, TokenURL: "https://auth.example.com/token", ClientID: "id"}, false},
+		{AuthConfig{Method: AuthClientCredentials, TokenURL: "https://auth.example.com/token", ClientID: "id", ClientSecret: "secret"}, true},
+		{AuthConfig{Method: AuthVP, ClientID: "did:elsi:1", CredentialFile: "vc.jwt", PrivateKeyFile: "key.pem"}, false},
+		{AuthConfig{Method: AuthVP, VerifierURL: "https://verifier.example.com", ClientID: "did:elsi:1", CredentialFile: "vc.jwt"}, false},
+		{AuthConfig{Method: AuthVP, VerifierURL: "https://verifier.example.com", ClientID: "did:elsi:1", CredentialFile: "vc.jwt", PrivateKeyFile: "key.pem"}, true},
+		{AuthConfig{Method: "basic"}, false},
+	}
+	for _, tc := range testCases {
+		if err := tc.auth.Validate(); (err == nil) != tc.valid {
+			t.Errorf("Unexpected validation of %+v: %v", tc.auth, err)
+		}
+	}
+
+	config := DefaultConfig()
+	config.Auth = AuthConfig{Method: AuthClientCredentials, Token: "token", ClientSecret: "secret"}
+	redacted := config.Redacted()
+	if redacted.Auth.Token != "REDACTED" || redacted.Auth.ClientSecret != "REDACTED" || config.Auth.ClientSecret != "secret" {
+		t.Errorf("Unexpected redacted config: %+v, original %+v", redacted.Auth, config.Auth)
+	}
+
+	t.Setenv("TMF_AUTH_METHOD", AuthBearer)
+	t.Setenv("TMF_AUTH_TOKEN", "from-env")
+	config = DefaultConfig()
+	config.LoadConfigFromEnv()
+	if config.Auth.Method != AuthBearer || config.Auth.Token != "from-env" {
+		t.Errorf("Unexpected auth from the environment: %+v", config.Auth)
+	}
+}
+
+// mockVerifier is a local OAuth2 server which issues access tokens with the client credentials grant,
+// authenticating the clients with a secret or with the Verifiable Presentation of the DOME login.
+// Its Protect method wraps a handler to require the tokens issued.
+type mockVerifier struct {
+	*httptest.Server
+
+	// ExpiresIn is the lifetime in seconds of the tokens issued, none if zero
+	ExpiresIn int
+
+	mu            sync.Mutex
+	secrets       map[string]string
+	holders       map[string]crypto.PublicKey
+	tokens        map[string]string
+	tokenRequests int
+}
+
+// newMockVerifier starts a mock verifier, which must be closed after use
+func newMockVerifier() *mockVerifier {
+	m := &mockVerifier{
+		secrets: make(map[string]string),
+		holders: make(map[string]crypto.PublicKey),
+		tokens:  make(map[string]string),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", m.openIDConfiguration)
+	mux.HandleFunc("POST /oidc/token", m.token)
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+// AddClient registers a client authenticated with a secret
+func (m *mockVerifier) AddClient(clientID, secret string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secrets[clientID] = secret
+}
+
+// AddHolder registers a client authenticated with a Verifiable Presentation signed with the key
+func (m *mockVerifier) AddHolder(clientID string, key crypto.PublicKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.holders[clientID] = key
+}
+
+// TokenRequests returns the number of tokens issued
+func (m *mockVerifier) TokenRequests() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tokenRequests
+}
+
+// RevokeAll revokes all the tokens issued
+func (m *mockVerifier) RevokeAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens = make(map[string]string)
+}
+
+// Protect returns a handler which replies with 401 to the requests without a token issued by the verifier
+func (m *mockVerifier) Protect(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		m.mu.Lock()
+		_, valid := m.tokens[token]
+		m.mu.Unlock()
+		if !found || !valid {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid access token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *mockVerifier) openIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                m.URL,
+		"token_endpoint":                        m.URL + "/oidc/token",
+		"grant_types_supported":                 []string{"client_credentials"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_post", "client_secret_basic", "private_key_jwt"},
+	})
+}
+
+func (m *mockVerifier) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		tokenError(w, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+
+	clientID := r.PostForm.Get("client_id")
+	var err error
+	if r.PostForm.Get("client_assertion") != "" {
+		err = m.checkPresentation(clientID, r.PostForm.Get("client_assertion_type"), r.PostForm.Get("client_assertion"))
+	} else {
+		secret := r.PostForm.Get("client_secret")
+		if id, s, ok := r.BasicAuth(); ok {
+			clientID, secret = id, s
+		}
+		m.mu.Lock()
+		expected, ok := m.secrets[clientID]
+		m.mu.Unlock()
+		if !ok || secret != expected {
+			err = fmt.Errorf("invalid client credentials")
+		}
+	}
+	if err != nil {
+		tokenError(w, "invalid_client", err.Error())
+		return
+	}
+
+	b := make([]byte, 16)
+	rand.Read(b)
+	token := hex.EncodeToString(b)
+	m.mu.Lock()
+	m.tokens[token] = clientID
+	m.tokenRequests++
+	m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tokenResponse{AccessToken: token, TokenType: "Bearer", ExpiresIn: m.ExpiresIn})
+}
+
+// checkPresentation verifies the client assertion and the presentation it carries, which must be signed
+// by the key of the holder and include some credential
+func (m *mockVerifier) checkPresentation(clientID, assertionType, assertion string) error {
+	m.mu.Lock()
+	key, ok := m.holders[clientID]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown holder %s", clientID)
+	}
+	if assertionType != clientAssertionType {
+		return fmt.Errorf("invalid client_assertion_type %q", assertionType)
+	}
+
+	keyFunc := func(*jwt.Token) (any, error) { return key, nil }
+	parser := jwt.NewParser(jwt.WithAudience(m.URL), jwt.WithIssuer(clientID), jwt.WithExpirationRequired())
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(assertion, claims, keyFunc); err != nil {
+		return fmt.Errorf("invalid client assertion: %w", err)
+	}
+	vpToken, _ := claims["vp_token"].(string)
+	vp, err := base64.RawURLEncoding.DecodeString(vpToken)
+	if err != nil {
+		return fmt.Errorf("invalid vp_token: %w", err)
+	}
+
+	vpClaims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(string(vp), vpClaims, keyFunc); err != nil {
+		return fmt.Errorf("invalid presentation: %w", err)
+	}
+	presentation, _ := vpClaims["vp"].(map[string]any)
+	credentials, _ := presentation["verifiableCredential"].([]any)
+	if presentation["holder"] != clientID || len(credentials) == 0 {
+		return fmt.Errorf("presentation without credentials of %s", clientID)
+	}
+	return nil
+}
+
+// tokenError writes an error response of the token endpoint
+func tokenError(w http.ResponseWriter, code, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code, "error_description": description})
+}
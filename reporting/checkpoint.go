@@ -0,0 +1,131 @@
+package reporting
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Checkpoint records the pages of objects retrieved from the server, so an interrupted run can be resumed
+// without retrieving them again. The file has a JSON line for each page, appended as they are retrieved.
+// The pages retrieved from a different server or with a different page size are ignored.
+type Checkpoint struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	baseURL string
+	limit   int
+	pages   map[string]map[int][]json.RawMessage
+}
+
+// checkpointPage is a line of the checkpoint file
+type checkpointPage struct {
+	BaseURL    string            `json:"base_url"`
+	ObjectType string            `json:"object_type"`
+	Offset     int               `json:"offset"`
+	Limit      int               `json:"limit"`
+	Objects    []json.RawMessage `json:"objects"`
+}
+
+// OpenCheckpoint reads the pages in the checkpoint file, if it exists, and opens it to add new pages
+func OpenCheckpoint(path, baseURL string, limit int) (*Checkpoint, error) {
+	cp := &Checkpoint{
+		path:    path,
+		baseURL: baseURL,
+		limit:   limit,
+		pages:   make(map[string]map[int][]json.RawMessage),
+	}
+
+	if f, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 256*1024*1024)
+		for scanner.Scan() {
+			var page checkpointPage
+			// The last line can be incomplete if the previous run was killed while writing it
+			if err := json.Unmarshal(scanner.Bytes(), &page); err != nil {
+				continue
+			}
+			if page.BaseURL == baseURL && page.Limit == limit {
+				cp.setPage(page.ObjectType, page.Offset, page.Objects)
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read checkpoint file: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to open checkpoint file: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open checkpoint file: %w", err)
+	}
+	cp.file = file
+	return cp, nil
+}
+
+func (cp *Checkpoint) setPage(objectType string, offset int, objects []json.RawMessage) {
+	if cp.pages[objectType] == nil {
+		cp.pages[objectType] = make(map[int][]json.RawMessage)
+	}
+	cp.pages[objectType][offset] = objects
+}
+
+// Page returns the objects of a page recorded in the checkpoint
+func (cp *Checkpoint) Page(objectType string, offset int) ([]json.RawMessage, bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	objects, ok := cp.pages[objectType][offset]
+	return objects, ok
+}
+
+// Pages returns the number of pages recorded in the checkpoint
+func (cp *Checkpoint) Pages() int {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	n := 0
+	for _, pages := range cp.pages {
+		n += len(pages)
+	}
+	return n
+}
+
+// SavePage records a page retrieved from the server
+func (cp *Checkpoint) SavePage(objectType string, offset int, objects []json.RawMessage) error {
+	line, err := json.Marshal(checkpointPage{
+		BaseURL:    cp.baseURL,
+		ObjectType: objectType,
+		Offset:     offset,
+		Limit:      cp.limit,
+		Objects:    objects,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if _, err := cp.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	cp.setPage(objectType, offset, objects)
+	return nil
+}
+
+// Close closes the checkpoint file, which is kept to resume the run
+func (cp *Checkpoint) Close() error {
+	return cp.file.Close()
+}
+
+// Remove closes and deletes the checkpoint file, when the run is complete
+func (cp *Checkpoint) Remove() error {
+	cp.file.Close()
+	if err := os.Remove(cp.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove checkpoint file: %w", err)
+	}
+	return nil
+}
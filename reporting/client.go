@@ -3,104 +3,324 @@ package reporting
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
-// Client represents an HTTP client for connecting to TMForum servers
+// Client represents an HTTP client for connecting to TMForum servers.
+// The requests are limited to config.Concurrency at the same time and to config.RequestsPerSecond,
+// and the ones failing with a network error, a 5xx or a 429 status are retried up to config.MaxRetries times.
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	timeout    time.Duration
+	httpClient  *http.Client
+	baseURL     string
+	timeout     time.Duration
+	concurrency int
+	requests    chan struct{}
+	limiter     *rate.Limiter
+	maxRetries  int
+	retryDelay  time.Duration
+	checkpoint  *Checkpoint
+	tokens      TokenSource
 }
 
+// maxRetryDelay is the maximum time waited before retrying a request
+const maxRetryDelay = 30 * time.Second
+
 // NewClient creates a new TMForum client
 func NewClient(config *Config) *Client {
-	return &Client{
+	concurrency := max(config.Concurrency, 1)
+	c := &Client{
 		httpClient: &http.Client{
 			Timeout: time.Duration(config.Timeout) * time.Second,
 		},
-		baseURL: config.BaseURL,
-		timeout: time.Duration(config.Timeout) * time.Second,
+		baseURL:     config.BaseURL,
+		timeout:     time.Duration(config.Timeout) * time.Second,
+		concurrency: concurrency,
+		requests:    make(chan struct{}, concurrency),
+		maxRetries:  max(config.MaxRetries, 0),
+		retryDelay:  500 * time.Millisecond,
 	}
+	if config.RequestsPerSecond > 0 {
+		c.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), 1)
+	}
+	return c
 }
 
-// GetObjectsWithPagination retrieves all objects of a specific type using pagination
-func (c *Client) GetObjectsWithPagination(ctx context.Context, objectType string, config *Config) ([]TMFObject, error) {
-	// Get the path prefix for this object type from the routes map
-	pathPrefix, exists := GeneratedDefaultResourceToPathPrefixV4[objectType]
-	if !exists {
-		return nil, fmt.Errorf("unknown object type: %s", objectType)
-	}
+// SetCheckpoint sets the checkpoint where the pages retrieved are recorded, and from which
+// the pages retrieved by a previous run are taken
+func (c *Client) SetCheckpoint(checkpoint *Checkpoint) {
+	c.checkpoint = checkpoint
+}
 
-	var allObjects []TMFObject
-	limit := config.PageSize
-	offset := 0
+// SetTokenSource sets the source of the access tokens sent in the requests, or nil for unauthenticated requests
+func (c *Client) SetTokenSource(tokens TokenSource) {
+	c.tokens = tokens
+}
+
+// statusError is returned when the server replies with an unexpected status
+type statusError struct {
+	statusCode int
+	body       string
+	retryAfter time.Duration
+}
 
-	for {
-		// Build URL with pagination parameters
-		url := fmt.Sprintf("%s%s?limit=%d&offset=%d", c.baseURL, pathPrefix, limit, offset)
-		fmt.Printf("Retrieving %s objects: offset=%d, limit=%d\n", objectType, offset, limit)
+func (e *statusError) Error() string {
+	return fmt.Sprintf("server returned status %d: %s", e.statusCode, e.body)
+}
 
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
+// get retrieves a URL, retrying with exponential backoff the requests which can succeed later
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	renewed := false
+	for attempt := 0; ; attempt++ {
+		// The failures to obtain an access token are not retried, as they are usually a wrong configuration
+		var token string
+		if c.tokens != nil {
+			var err error
+			if token, err = c.tokens.Token(ctx); err != nil {
+				return nil, fmt.Errorf("failed to obtain access token: %w", err)
+			}
 		}
 
-		// Set common headers
-		req.Header.Set("Accept", "application/json")
-		req.Header.Set("Content-Type", "application/json")
+		body, err := c.getOnce(ctx, url, token)
+		if err == nil {
+			return body, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to execute request: %w", err)
+		// Only the server errors, the rate limiting by the server and the network errors are retried
+		delay := c.retryDelay << attempt
+		var se *statusError
+		if errors.As(err, &se) {
+			// An access token rejected by the server, which may have revoked it, is renewed once if possible
+			if se.statusCode == http.StatusUnauthorized && c.tokens != nil && !renewed && c.tokens.Invalidate(token) {
+				renewed = true
+				attempt--
+				continue
+			}
+			if se.statusCode != http.StatusTooManyRequests && se.statusCode < 500 {
+				return nil, err
+			}
+			delay = max(delay, se.retryAfter)
+		}
+		if attempt >= c.maxRetries {
+			return nil, err
 		}
-		defer resp.Body.Close()
+		delay = min(delay, maxRetryDelay)
+		log.Printf("Request to %s failed (attempt %d): %v, retrying in %v", url, attempt+1, err, delay)
 
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, string(body))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
+	}
+}
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
+// getOnce performs a request with the access token, if any, waiting for the limits of concurrency and rate
+func (c *Client) getOnce(ctx context.Context, url string, token string) ([]byte, error) {
+	select {
+	case c.requests <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-c.requests }()
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
 		}
+	}
 
-		// Try to parse as an array first
-		var objects []TMFObject
-		if err := json.Unmarshal(body, &objects); err != nil {
-			// If it's not an array, try to parse as a single object
-			var singleObject TMFObject
-			if err := json.Unmarshal(body, &singleObject); err != nil {
-				return nil, fmt.Errorf("failed to parse response as JSON: %w", err)
-			}
-			objects = []TMFObject{singleObject}
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set common headers
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		se := &statusError{statusCode: resp.StatusCode, body: string(body)}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			se.retryAfter = time.Duration(seconds) * time.Second
 		}
+		return nil, se
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, nil
+}
 
+// splitObjects returns the objects in a response, which can be an array or a single object
+func splitObjects(body []byte) ([]json.RawMessage, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		// If it's not an array, try to parse as a single object
+		var single map[string]any
+		if err := json.Unmarshal(body, &single); err != nil {
+			return nil, fmt.Errorf("failed to parse response as JSON: %w", err)
+		}
+		raw = []json.RawMessage{body}
+	}
+	return raw, nil
+}
+
+// parseObjects converts the objects in the response to TMFObjects
+func (c *Client) parseObjects(raw []json.RawMessage) ([]TMFObject, error) {
+	objects := make([]TMFObject, len(raw))
+	for i, r := range raw {
+		if err := json.Unmarshal(r, &objects[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse object: %w", err)
+		}
 		// Process each object to extract additional fields
-		for i := range objects {
-			objects[i] = c.processObject(objects[i], body)
+		objects[i] = c.processObject(objects[i], r)
+	}
+	return objects, nil
+}
+
+// getPage retrieves a page of objects, from the checkpoint if it was retrieved by a previous run
+func (c *Client) getPage(ctx context.Context, objectType, pathPrefix string, offset, limit int) ([]TMFObject, error) {
+	if c.checkpoint != nil {
+		if raw, ok := c.checkpoint.Page(objectType, offset); ok {
+			return c.parseObjects(raw)
+		}
+	}
+
+	// Build URL with pagination parameters
+	url := fmt.Sprintf("%s%s?limit=%d&offset=%d", c.baseURL, pathPrefix, limit, offset)
+	fmt.Printf("Retrieving %s objects: offset=%d, limit=%d\n", objectType, offset, limit)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := splitObjects(body)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := c.parseObjects(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.checkpoint != nil {
+		if err := c.checkpoint.SavePage(objectType, offset, raw); err != nil {
+			return nil, err
 		}
+	}
+	return objects, nil
+}
 
-		// Add objects from this page to the total collection
-		allObjects = append(allObjects, objects...)
+// GetObjectsWithPagination retrieves all objects of a specific type using pagination.
+// Several pages are retrieved at the same time, so some requests may be made after the last page,
+// but the objects are returned in the order of the pages.
+func (c *Client) GetObjectsWithPagination(ctx context.Context, objectType string, config *Config) ([]TMFObject, error) {
+	// Get the path prefix for this object type from the routes map
+	pathPrefix, exists := GeneratedDefaultResourceToPathPrefixV4[objectType]
+	if !exists {
+		return nil, fmt.Errorf("unknown object type: %s", objectType)
+	}
 
-		// If we got fewer objects than the limit, we've reached the end
-		if len(objects) < limit {
-			break
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := config.PageSize
+	var (
+		mu       sync.Mutex
+		pages    = make(map[int][]TMFObject)
+		errs     = make(map[int]error)
+		next     = 0
+		last     = -1 // offset of the last page, when known
+		failed   = -1 // offset of the first page which failed, if any
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	worker := func() {
+		defer wg.Done()
+		for {
+			mu.Lock()
+			// Safety check to prevent infinite loops
+			done := firstErr != nil || (last >= 0 && next > last) || (failed >= 0 && next > failed) || (next > 0 && next >= config.MaxObjects)
+			offset := next
+			next += limit
+			mu.Unlock()
+			if done {
+				return
+			}
+
+			objects, err := c.getPage(ctx, objectType, pathPrefix, offset, limit)
+
+			mu.Lock()
+			if err != nil {
+				errs[offset] = err
+				if failed < 0 || offset < failed {
+					failed = offset
+				}
+			} else {
+				pages[offset] = objects
+				// If we got fewer objects than the limit, we've reached the end
+				if len(objects) < limit && (last < 0 || offset < last) {
+					last = offset
+				}
+			}
+			// Many servers reply with an error to the pages past the total, which are requested concurrently
+			// before the last page is known, so only the errors up to the last page are fatal
+			if firstErr == nil && failed >= 0 && last >= 0 && failed <= last {
+				firstErr = errs[failed]
+				cancel()
+			}
+			mu.Unlock()
 		}
+	}
 
-		// Move to next page
-		offset += limit
+	for range c.concurrency {
+		wg.Add(1)
+		go worker()
+	}
+	wg.Wait()
+
+	// Without a page with fewer objects, the end is not known and any error is fatal
+	if firstErr == nil && failed >= 0 && (last < 0 || failed <= last) {
+		firstErr = errs[failed]
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
 
-		// Safety check to prevent infinite loops
-		if offset >= config.MaxObjects {
+	var allObjects []TMFObject
+	for offset := 0; ; offset += limit {
+		objects, ok := pages[offset]
+		if !ok {
 			fmt.Printf("Warning: Reached maximum objects limit (%d) for %s\n", config.MaxObjects, objectType)
 			break
 		}
+		allObjects = append(allObjects, objects...)
+		if offset == last {
+			break
+		}
 	}
 
 	fmt.Printf("Total %s objects retrieved: %d\n", objectType, len(allObjects))
@@ -126,48 +346,15 @@ func (c *Client) GetObjectsWithoutPagination(ctx context.Context, objectType str
 	url := fmt.Sprintf("%s%s", c.baseURL, pathPrefix)
 	fmt.Printf("URL: %s\n", url)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	body, err := c.get(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
-
-	// Set common headers
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	raw, err := splitObjects(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	// Try to parse as an array first
-	var objects []TMFObject
-	if err := json.Unmarshal(body, &objects); err != nil {
-		// If it's not an array, try to parse as a single object
-		var singleObject TMFObject
-		if err := json.Unmarshal(body, &singleObject); err != nil {
-			return nil, fmt.Errorf("failed to parse response as JSON: %w", err)
-		}
-		objects = []TMFObject{singleObject}
-	}
-
-	// Process each object to extract additional fields
-	for i := range objects {
-		objects[i] = c.processObject(objects[i], body)
-	}
-
-	return objects, nil
+	return c.parseObjects(raw)
 }
 
 // processObject processes a TMF object and extracts additional fields
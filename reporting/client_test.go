@@ -1,7 +1,17 @@
 package reporting
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestGetObjectsURLBuilding(t *testing.T) {
@@ -117,3 +127,186 @@ func TestRoutesMapCompleteness(t *testing.T) {
 		}
 	}
 }
+
+// pageServer serves n productOffering objects with pagination, failing the first request of each page
+// with a 503 and counting the requests and the maximum requests at the same time.
+// If notFoundPastEnd is set, the pages past the total are replied with a 404, like some TMF servers do.
+type pageServer struct {
+	mu              sync.Mutex
+	n               int
+	requests        map[string]int
+	inFlight        int
+	maxFlight       int
+	failAt          int
+	notFoundPastEnd bool
+}
+
+func (s *pageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.inFlight++
+	s.maxFlight = max(s.maxFlight, s.inFlight)
+	s.requests[r.URL.RawQuery]++
+	attempt := s.requests[r.URL.RawQuery]
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if attempt == 1 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if s.failAt > 0 && offset == s.failAt {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if s.notFoundPastEnd && offset >= s.n {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	objects := []map[string]any{}
+	for i := offset; i < min(offset+limit, s.n); i++ {
+		objects = append(objects, map[string]any{"id": fmt.Sprintf("po-%03d", i), "lifecycleStatus": "Launched"})
+	}
+	json.NewEncoder(w).Encode(objects)
+}
+
+func TestGetObjectsConcurrently(t *testing.T) {
+	server := &pageServer{n: 95, requests: map[string]int{}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = ts.URL
+	config.PageSize = 10
+	config.Concurrency = 3
+	config.RequestsPerSecond = 0
+	client := NewClient(config)
+	client.retryDelay = time.Millisecond
+
+	objects, err := client.GetObjects(context.Background(), "productOffering", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 95 {
+		t.Fatalf("Expected 95 objects, got %d", len(objects))
+	}
+	for i, obj := range objects {
+		if obj.ID != fmt.Sprintf("po-%03d", i) || obj.AdditionalFields["lifecycleStatus"] != "Launched" {
+			t.Fatalf("Unexpected object %d: %+v", i, obj)
+		}
+	}
+	if server.maxFlight > 3 || server.maxFlight < 2 {
+		t.Errorf("Expected at most 3 requests at the same time, got %d", server.maxFlight)
+	}
+	if server.requests["limit=10&offset=0"] != 2 {
+		t.Errorf("Expected a retry of the first page, got %d requests", server.requests["limit=10&offset=0"])
+	}
+
+	// Errors other than 5xx and 429 are not retried
+	server.failAt = 20
+	server.requests = map[string]int{}
+	client.maxRetries = 5
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err == nil || server.requests["limit=10&offset=20"] != 2 {
+		t.Errorf("Expected error without more retries, got %v and %d requests", err, server.requests["limit=10&offset=20"])
+	}
+}
+
+func TestGetObjectsErrorsPastEnd(t *testing.T) {
+	server := &pageServer{n: 95, requests: map[string]int{}, notFoundPastEnd: true}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = ts.URL
+	config.PageSize = 10
+	config.Concurrency = 4
+	config.RequestsPerSecond = 0
+	client := NewClient(config)
+	client.retryDelay = time.Millisecond
+
+	// The pages past the last one are requested before it is known, and their errors are ignored
+	objects, err := client.GetObjects(context.Background(), "productOffering", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 95 {
+		t.Fatalf("Expected 95 objects, got %d", len(objects))
+	}
+	if server.requests["limit=10&offset=100"] == 0 {
+		t.Errorf("Expected requests past the last page, got %v", server.requests)
+	}
+
+	// The errors before the last page are not
+	server.failAt = 50
+	server.requests = map[string]int{}
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err == nil {
+		t.Error("Expected error for a page before the last one")
+	}
+}
+
+func TestGetObjectsRateLimit(t *testing.T) {
+	server := &pageServer{n: 30, requests: map[string]int{}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = ts.URL
+	config.PageSize = 10
+	config.RequestsPerSecond = 50
+	client := NewClient(config)
+	client.retryDelay = time.Millisecond
+
+	// 4 pages with a retry each are 8 requests, which take at least 140ms at 50 per second
+	start := time.Now()
+	if _, err := client.GetObjects(context.Background(), "productOffering", config); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Errorf("Requests were not rate limited, took %v", elapsed)
+	}
+}
+
+func TestGetObjectsCheckpoint(t *testing.T) {
+	server := &pageServer{n: 45, requests: map[string]int{}, failAt: 30}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	config := DefaultConfig()
+	config.BaseURL = ts.URL
+	config.PageSize = 10
+	config.Concurrency = 1
+	config.RequestsPerSecond = 0
+	config.ObjectTypes = []string{"productOffering"}
+	config.CheckpointFile = filepath.Join(t.TempDir(), "checkpoint.jsonl")
+
+	proxy, err := NewProxy(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy.client.retryDelay = time.Millisecond
+	_, errs, _ := proxy.retrieveAll(context.Background(), func(string, int, error) {})
+	if errs[0] == nil {
+		t.Fatal("Expected error retrieving the objects")
+	}
+
+	// The pages retrieved are not requested again when resuming
+	server.failAt = 0
+	server.requests = map[string]int{}
+	objectsByType, errs, _ := proxy.retrieveAll(context.Background(), func(string, int, error) {})
+	if errs[0] != nil || len(objectsByType[0]) != 45 {
+		t.Fatalf("Unexpected result resuming: %v, %d objects", errs[0], len(objectsByType[0]))
+	}
+	if server.requests["limit=10&offset=0"] != 0 || server.requests["limit=10&offset=30"] == 0 {
+		t.Errorf("Unexpected requests resuming: %v", server.requests)
+	}
+	if _, err := os.Stat(config.CheckpointFile); !os.IsNotExist(err) {
+		t.Errorf("Expected checkpoint to be deleted after completing: %v", err)
+	}
+}
@@ -1,10 +1,17 @@
 package reporting
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+
+	"github.com/goccy/go-yaml"
 )
 
 const VersionV4 = "v4"
@@ -27,13 +34,89 @@ type Config struct {
 	PageSize          int  `json:"page_size" yaml:"page_size"`     // objects per page
 	MaxObjects        int  `json:"max_objects" yaml:"max_objects"` // maximum objects to retrieve
 
+	// Retrieval settings. The object types and their pages are retrieved in parallel, with at most
+	// Concurrency requests at the same time and RequestsPerSecond (unlimited if zero). The requests failing
+	// with a network error, a 5xx or a 429 status are retried up to MaxRetries times, with exponential backoff.
+	Concurrency       int     `json:"concurrency" yaml:"concurrency"`
+	RequestsPerSecond float64 `json:"requests_per_second" yaml:"requests_per_second"`
+	MaxRetries        int     `json:"max_retries" yaml:"max_retries"`
+
+	// Authentication of the requests, to retrieve private and draft objects
+	Auth AuthConfig `json:"auth" yaml:"auth"`
+
+	// File where the pages retrieved are recorded, to resume an interrupted run. It is deleted when the run completes.
+	CheckpointFile string `json:"checkpoint_file" yaml:"checkpoint_file"`
+
 	// Validation settings
 	ValidateRequiredFields bool `json:"validate_required_fields" yaml:"validate_required_fields"`
 	ValidateRelatedParty   bool `json:"validate_related_party" yaml:"validate_related_party"`
 
+	// File with the validation rules (YAML). The sections not in the file keep the default rules.
+	RulesFile string `json:"rules_file" yaml:"rules_file"`
+
+	// Directory with validation rules written in Starlark (*.star files)
+	RulesDir string `json:"rules_dir" yaml:"rules_dir"`
+
 	// Output settings
 	OutputDir  string `json:"output_dir" yaml:"output_dir"`
 	ReportFile string `json:"report_file" yaml:"report_file"`
+
+	// Formats of the reports generated, with the name of ReportFile and the extension of each format
+	ReportFormats []string `json:"report_formats" yaml:"report_formats"`
+
+	// Generate a report for each seller in the subdirectory "sellers" of OutputDir, with an index
+	ReportBySeller bool `json:"report_by_seller" yaml:"report_by_seller"`
+
+	// SQLite database where the reports are stored, to compare them with the previous ones
+	HistoryDB string `json:"history_db" yaml:"history_db"`
+}
+
+// Authentication methods of the requests to the server
+const (
+	AuthNone              = ""
+	AuthBearer            = "bearer"
+	AuthClientCredentials = "client_credentials"
+	AuthVP                = "vp"
+)
+
+// AuthConfig holds the settings of the authentication of the requests to the server
+type AuthConfig struct {
+	// Method is one of "" (no authentication), "bearer", "client_credentials" or "vp"
+	Method string `json:"method" yaml:"method"`
+
+	// Token is the access token for the bearer method, or the file in TokenFile, which is read again
+	// in every request so it can be renewed by another process
+	Token     string `json:"token,omitempty" yaml:"token,omitempty"`
+	TokenFile string `json:"token_file,omitempty" yaml:"token_file,omitempty"`
+
+	// TokenURL is the token endpoint of the OAuth2 server. For the vp method it is discovered from
+	// the OpenID configuration of the VerifierURL if not specified.
+	TokenURL     string `json:"token_url,omitempty" yaml:"token_url,omitempty"`
+	ClientID     string `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+	Scope        string `json:"scope,omitempty" yaml:"scope,omitempty"`
+
+	// VerifierURL is the DOME verifier for the vp method. The client presents the credential in CredentialFile
+	// (a LEARCredentialMachine in JWT format) in a Verifiable Presentation signed with the private key of the
+	// holder in PrivateKeyFile (PEM), and ClientID is the DID of the holder.
+	VerifierURL    string `json:"verifier_url,omitempty" yaml:"verifier_url,omitempty"`
+	CredentialFile string `json:"credential_file,omitempty" yaml:"credential_file,omitempty"`
+	PrivateKeyFile string `json:"private_key_file,omitempty" yaml:"private_key_file,omitempty"`
+}
+
+// redactedSecret replaces the secrets in the configuration included in the reports
+const redactedSecret = "REDACTED"
+
+// Redacted returns a copy of the configuration without the secrets, to include it in reports and logs
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	if redacted.Auth.Token != "" {
+		redacted.Auth.Token = redactedSecret
+	}
+	if redacted.Auth.ClientSecret != "" {
+		redacted.Auth.ClientSecret = redactedSecret
+	}
+	return &redacted
 }
 
 // DefaultConfig returns a default configuration
@@ -46,10 +129,14 @@ func DefaultConfig() *Config {
 		PaginationEnabled:      true,
 		PageSize:               100,
 		MaxObjects:             10000,
+		Concurrency:            4,
+		RequestsPerSecond:      10,
+		MaxRetries:             3,
 		ValidateRequiredFields: true,
 		ValidateRelatedParty:   true,
 		OutputDir:              "./reports",
 		ReportFile:             "tmf_validation_report.md",
+		ReportFormats:          []string{FormatMarkdown},
 	}
 }
 
@@ -64,6 +151,52 @@ func DefaultObjectTypes() []string {
 	return objectTypes
 }
 
+// Validate checks that the settings required by the authentication method are present
+func (a *AuthConfig) Validate() error {
+	switch a.Method {
+	case AuthNone:
+	case AuthBearer:
+		if a.Token == "" && a.TokenFile == "" {
+			return fmt.Errorf("token or token_file is required for the bearer method")
+		}
+	case AuthClientCredentials:
+		if a.TokenURL == "" || a.ClientID == "" || a.ClientSecret == "" {
+			return fmt.Errorf("token_url, client_id and client_secret are required for the client_credentials method")
+		}
+	case AuthVP:
+		if a.TokenURL == "" && a.VerifierURL == "" {
+			return fmt.Errorf("verifier_url or token_url is required for the vp method")
+		}
+		if a.ClientID == "" || a.CredentialFile == "" || a.PrivateKeyFile == "" {
+			return fmt.Errorf("client_id, credential_file and private_key_file are required for the vp method")
+		}
+	default:
+		return fmt.Errorf("unknown method %q, expected bearer, client_credentials or vp", a.Method)
+	}
+	return nil
+}
+
+// LoadConfigFromFile loads configuration from a JSON (.json extension) or YAML file.
+// The settings not in the file keep their current values, and unknown settings are an error.
+func (c *Config) LoadConfigFromFile(filename string) error {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(c)
+	} else {
+		err = yaml.NewDecoder(bytes.NewReader(content), yaml.Strict()).Decode(c)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", filename, err)
+	}
+	return nil
+}
+
 // LoadConfigFromEnv loads configuration from environment variables
 func (c *Config) LoadConfigFromEnv() {
 	if baseURL := os.Getenv("TMF_BASE_URL"); baseURL != "" {
@@ -71,7 +204,7 @@ func (c *Config) LoadConfigFromEnv() {
 	}
 
 	if timeout := os.Getenv("TMF_TIMEOUT"); timeout != "" {
-		if t, err := fmt.Sscanf(timeout, "%d", &c.Timeout); err == nil && t > 0 {
+		if t, err := strconv.Atoi(timeout); err == nil && t > 0 {
 			c.Timeout = t
 		}
 	}
@@ -91,6 +224,53 @@ func (c *Config) LoadConfigFromEnv() {
 		c.ReportFile = reportFile
 	}
 
+	if reportFormats := os.Getenv("TMF_REPORT_FORMATS"); reportFormats != "" {
+		c.ReportFormats = strings.Split(reportFormats, ",")
+		for i, f := range c.ReportFormats {
+			c.ReportFormats[i] = strings.TrimSpace(f)
+		}
+	}
+
+	if reportBySeller := os.Getenv("TMF_REPORT_BY_SELLER"); reportBySeller != "" {
+		if enabled, err := strconv.ParseBool(reportBySeller); err == nil {
+			c.ReportBySeller = enabled
+		}
+	}
+
+	if checkpointFile := os.Getenv("TMF_CHECKPOINT_FILE"); checkpointFile != "" {
+		c.CheckpointFile = checkpointFile
+	}
+
+	// Load authentication settings from environment
+	for env, value := range map[string]*string{
+		"TMF_AUTH_METHOD":           &c.Auth.Method,
+		"TMF_AUTH_TOKEN":            &c.Auth.Token,
+		"TMF_AUTH_TOKEN_FILE":       &c.Auth.TokenFile,
+		"TMF_AUTH_TOKEN_URL":        &c.Auth.TokenURL,
+		"TMF_AUTH_CLIENT_ID":        &c.Auth.ClientID,
+		"TMF_AUTH_CLIENT_SECRET":    &c.Auth.ClientSecret,
+		"TMF_AUTH_SCOPE":            &c.Auth.Scope,
+		"TMF_AUTH_VERIFIER_URL":     &c.Auth.VerifierURL,
+		"TMF_AUTH_CREDENTIAL_FILE":  &c.Auth.CredentialFile,
+		"TMF_AUTH_PRIVATE_KEY_FILE": &c.Auth.PrivateKeyFile,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*value = v
+		}
+	}
+
+	if historyDB := os.Getenv("TMF_HISTORY_DB"); historyDB != "" {
+		c.HistoryDB = historyDB
+	}
+
+	if rulesFile := os.Getenv("TMF_RULES_FILE"); rulesFile != "" {
+		c.RulesFile = rulesFile
+	}
+
+	if rulesDir := os.Getenv("TMF_RULES_DIR"); rulesDir != "" {
+		c.RulesDir = rulesDir
+	}
+
 	// Load pagination settings from environment
 	if paginationEnabled := os.Getenv("TMF_PAGINATION_ENABLED"); paginationEnabled != "" {
 		if enabled, err := strconv.ParseBool(paginationEnabled); err == nil {
@@ -109,6 +289,25 @@ func (c *Config) LoadConfigFromEnv() {
 			c.MaxObjects = max
 		}
 	}
+
+	// Load retrieval settings from environment
+	if concurrency := os.Getenv("TMF_CONCURRENCY"); concurrency != "" {
+		if n, err := strconv.Atoi(concurrency); err == nil && n > 0 {
+			c.Concurrency = n
+		}
+	}
+
+	if rps := os.Getenv("TMF_REQUESTS_PER_SECOND"); rps != "" {
+		if r, err := strconv.ParseFloat(rps, 64); err == nil && r >= 0 {
+			c.RequestsPerSecond = r
+		}
+	}
+
+	if maxRetries := os.Getenv("TMF_MAX_RETRIES"); maxRetries != "" {
+		if n, err := strconv.Atoi(maxRetries); err == nil && n >= 0 {
+			c.MaxRetries = n
+		}
+	}
 }
 
 // Validate checks if the configuration is valid
@@ -125,6 +324,24 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("timeout must be positive")
 	}
 
+	if c.PaginationEnabled && c.PageSize <= 0 {
+		return fmt.Errorf("page size must be positive")
+	}
+
+	if c.Concurrency < 0 || c.RequestsPerSecond < 0 || c.MaxRetries < 0 {
+		return fmt.Errorf("concurrency, requests per second and max retries can not be negative")
+	}
+
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid authentication: %w", err)
+	}
+
+	for _, format := range c.ReportFormats {
+		if _, ok := reportExtensions[format]; !ok {
+			return fmt.Errorf("unknown report format %q, expected one of %s", format, strings.Join(ReportFormats(), ", "))
+		}
+	}
+
 	c.BaseURL = strings.TrimRight(c.BaseURL, "/")
 
 	return nil
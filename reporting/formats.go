@@ -0,0 +1,417 @@
+package reporting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats of the reports
+const (
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+	FormatJUnit    = "junit"
+	FormatSARIF    = "sarif"
+)
+
+// reportExtensions are the extensions of the report files in each format
+var reportExtensions = map[string]string{
+	FormatMarkdown: ".md",
+	FormatJSON:     ".json",
+	FormatHTML:     ".html",
+	FormatCSV:      ".csv",
+	FormatJUnit:    ".junit.xml",
+	FormatSARIF:    ".sarif",
+}
+
+// ReportFormats returns the names of the formats supported, sorted
+func ReportFormats() []string {
+	formats := make([]string, 0, len(reportExtensions))
+	for format := range reportExtensions {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// WriteReport writes the report in one of the formats supported
+func (r *Reporter) WriteReport(w io.Writer, report *ValidationReport, format string) error {
+	switch format {
+	case FormatMarkdown:
+		return r.writeMarkdownReport(w, report)
+	case FormatJSON:
+		return writeJSONReport(w, report)
+	case FormatHTML:
+		return writeHTMLReport(w, report)
+	case FormatCSV:
+		return writeCSVReport(w, report)
+	case FormatJUnit:
+		return writeJUnitReport(w, report)
+	case FormatSARIF:
+		return writeSARIFReport(w, report)
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// sortedResults returns the results ordered by object type, keeping the order of the objects of each type
+func sortedResults(results []ValidationResult) []ValidationResult {
+	sorted := make([]ValidationResult, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ObjectType < sorted[j].ObjectType
+	})
+	return sorted
+}
+
+// writeJSONReport writes the complete report as JSON
+func writeJSONReport(w io.Writer, report *ValidationReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// writeCSVReport writes a row for each object, with its errors and warnings in the last columns
+func writeCSVReport(w io.Writer, report *ValidationReport) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"object_type", "object_id", "valid", "errors", "warnings", "error_codes", "warning_codes", "problems", "timestamp"})
+
+	for _, result := range sortedResults(report.Results) {
+		var errorCodes, warningCodes, problems []string
+		for _, e := range result.Errors {
+			errorCodes = append(errorCodes, e.Code)
+			problems = append(problems, fmt.Sprintf("error %s: %s", e.Field, e.Message))
+		}
+		for _, warning := range result.Warnings {
+			warningCodes = append(warningCodes, warning.Code)
+			problems = append(problems, fmt.Sprintf("warning %s: %s", warning.Field, warning.Message))
+		}
+		writer.Write([]string{
+			result.ObjectType,
+			result.ObjectID,
+			strconv.FormatBool(result.Valid),
+			strconv.Itoa(len(result.Errors)),
+			strconv.Itoa(len(result.Warnings)),
+			strings.Join(errorCodes, " "),
+			strings.Join(warningCodes, " "),
+			strings.Join(problems, "; "),
+			result.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     float64          `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite groups the objects of a type
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is the validation of an object, which fails if the object is invalid
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnitReport writes a test suite for each object type, with a test case for each object.
+// Invalid objects are failures listing their errors, and the warnings are written to the output of the test case.
+func writeJUnitReport(w io.Writer, report *ValidationReport) error {
+	suites := junitTestSuites{
+		Name:     "TMForum Object Validation",
+		Tests:    report.Statistics.TotalObjects,
+		Failures: report.Statistics.InvalidObjects,
+		Time:     report.Statistics.Duration.Seconds(),
+	}
+
+	index := make(map[string]int)
+	for _, result := range sortedResults(report.Results) {
+		i, ok := index[result.ObjectType]
+		if !ok {
+			i = len(suites.Suites)
+			index[result.ObjectType] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{
+				Name:      result.ObjectType,
+				Timestamp: report.GeneratedAt.Format("2006-01-02T15:04:05"),
+			})
+		}
+		suite := &suites.Suites[i]
+		suite.Tests++
+
+		testCase := junitTestCase{Name: result.ObjectID, ClassName: result.ObjectType}
+		if !result.Valid {
+			suite.Failures++
+			var codes, lines []string
+			for _, e := range result.Errors {
+				codes = append(codes, e.Code)
+				lines = append(lines, fmt.Sprintf("%s: %s (Code: %s)", e.Field, e.Message, e.Code))
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d validation errors: %s", len(result.Errors), strings.Join(codes, ", ")),
+				Type:    "ValidationError",
+				Text:    strings.Join(lines, "\n"),
+			}
+		}
+		var warnings []string
+		for _, warning := range result.Warnings {
+			warnings = append(warnings, fmt.Sprintf("warning %s: %s (Code: %s)", warning.Field, warning.Message, warning.Code))
+		}
+		testCase.SystemOut = strings.Join(warnings, "\n")
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// sarifSchema and sarifVersion identify the version of the SARIF format generated
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string          `json:"ruleId"`
+	Level      string          `json:"level"`
+	Message    sarifMessage    `json:"message"`
+	Locations  []sarifLocation `json:"locations"`
+	Properties map[string]any  `json:"properties"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// writeSARIFReport writes a result for each error and warning. The codes of the problems are the rules,
+// and the locations are the fields of the objects, as there are no source files.
+func writeSARIFReport(w io.Writer, report *ValidationReport) error {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "TMForum Reporting Validator"}},
+		Results: []sarifResult{},
+	}
+
+	rules := make(map[string]bool)
+	addResult := func(result ValidationResult, code, field, message, level string) {
+		if !rules[code] {
+			rules[code] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: code, ShortDescription: sarifMessage{Text: code}})
+		}
+		name := result.ObjectID
+		if field != "" {
+			name += "/" + field
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  code,
+			Level:   level,
+			Message: sarifMessage{Text: fmt.Sprintf("%s %s: %s", result.ObjectType, result.ObjectID, message)},
+			Locations: []sarifLocation{{LogicalLocations: []sarifLogicalLocation{{
+				Name:               field,
+				FullyQualifiedName: name,
+				Kind:               "member",
+			}}}},
+			Properties: map[string]any{"objectType": result.ObjectType, "objectId": result.ObjectID},
+		})
+	}
+
+	for _, result := range sortedResults(report.Results) {
+		for _, e := range result.Errors {
+			addResult(result, e.Code, e.Field, e.Message, "error")
+		}
+		for _, warning := range result.Warnings {
+			addResult(result, warning.Code, warning.Field, warning.Message, "warning")
+		}
+	}
+	sort.Slice(run.Tool.Driver.Rules, func(i, j int) bool {
+		return run.Tool.Driver.Rules[i].ID < run.Tool.Driver.Rules[j].ID
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}})
+}
+
+// htmlReport is the data used by the HTML template
+type htmlReport struct {
+	*ValidationReport
+	ObjectTypes []string
+	Results     []ValidationResult
+}
+
+// writeHTMLReport writes a self-contained HTML page, with the styles and the script to filter the
+// results by object type, validity and text embedded in the page
+func writeHTMLReport(w io.Writer, report *ValidationReport) error {
+	data := htmlReport{ValidationReport: report, Results: sortedResults(report.Results)}
+	for objectType := range report.Statistics.ObjectsByType {
+		data.ObjectTypes = append(data.ObjectTypes, objectType)
+	}
+	sort.Strings(data.ObjectTypes)
+	return htmlReportTemplate.Execute(w, data)
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("2006-01-02 15:04:05 UTC") },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>TMForum Object Validation Report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+tr.invalid td.status { color: #b00020; font-weight: bold; }
+tr.valid td.status { color: #1b5e20; }
+ul { margin: 0; padding-left: 1.2em; }
+li.error { color: #b00020; }
+li.warning { color: #8a6d00; }
+#filters { margin-bottom: 1em; }
+#filters > * { margin-right: 1em; }
+code { font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>TMForum Object Validation Report</h1>
+<p><strong>Generated:</strong> {{date .GeneratedAt}}<br>
+<strong>Base URL:</strong> <code>{{.Config.BaseURL}}</code>{{if .Seller}}<br>
+<strong>Seller:</strong> <code>{{.Seller}}</code>{{end}}</p>
+
+<h2>Summary Statistics</h2>
+<table>
+<tr><th>Total Objects</th><td>{{.Statistics.TotalObjects}}</td></tr>
+<tr><th>Valid Objects</th><td>{{.Statistics.ValidObjects}}</td></tr>
+<tr><th>Invalid Objects</th><td>{{.Statistics.InvalidObjects}}</td></tr>
+<tr><th>Total Errors</th><td>{{.Statistics.TotalErrors}}</td></tr>
+<tr><th>Total Warnings</th><td>{{.Statistics.TotalWarnings}}</td></tr>
+<tr><th>Processing Time</th><td>{{.Statistics.Duration}}</td></tr>
+</table>
+
+<h2>Statistics by Object Type</h2>
+<table>
+<tr><th>Object Type</th><th>Count</th><th>Valid</th><th>Invalid</th><th>Errors</th><th>Warnings</th></tr>
+{{range $type := .ObjectTypes}}{{with index $.Statistics.ObjectsByType $type}}<tr><td>{{$type}}</td><td>{{.Count}}</td><td>{{.Valid}}</td><td>{{.Invalid}}</td><td>{{.Errors}}</td><td>{{.Warnings}}</td></tr>
+{{end}}{{end}}</table>
+
+<h2>Validation Results</h2>
+<div id="filters">
+<label>Object type <select id="type"><option value="">All</option>{{range .ObjectTypes}}<option>{{.}}</option>{{end}}</select></label>
+<label>Status <select id="status"><option value="">All</option><option value="invalid">Invalid</option><option value="valid">Valid</option><option value="warnings">With warnings</option></select></label>
+<label>Search <input id="search" type="search" placeholder="id, field, code or message"></label>
+<span id="count"></span>
+</div>
+<table id="results">
+<tr><th>Object Type</th><th>Object</th><th>Status</th><th>Problems</th></tr>
+{{range .Results}}<tr class="{{if .Valid}}valid{{else}}invalid{{end}}" data-type="{{.ObjectType}}" data-valid="{{.Valid}}" data-warnings="{{len .Warnings}}">
+<td>{{.ObjectType}}</td><td><code>{{.ObjectID}}</code></td><td class="status">{{if .Valid}}Valid{{else}}Invalid{{end}}</td>
+<td>{{if or .Errors .Warnings}}<ul>{{range .Errors}}<li class="error">{{.Field}}: {{.Message}} ({{.Code}})</li>{{end}}{{range .Warnings}}<li class="warning">{{.Field}}: {{.Message}} ({{.Code}})</li>{{end}}</ul>{{end}}</td>
+</tr>
+{{end}}</table>
+
+<p><em>Report generated by TMForum Proxy Validator at {{date .GeneratedAt}}</em></p>
+
+<script>
+(function () {
+  var type = document.getElementById("type");
+  var status = document.getElementById("status");
+  var search = document.getElementById("search");
+  var count = document.getElementById("count");
+  var rows = Array.prototype.slice.call(document.querySelectorAll("#results tr[data-type]"));
+  function filter() {
+    var text = search.value.toLowerCase();
+    var shown = 0;
+    rows.forEach(function (row) {
+      var visible = (!type.value || row.dataset.type === type.value) &&
+        (!status.value ||
+          (status.value === "valid" && row.dataset.valid === "true") ||
+          (status.value === "invalid" && row.dataset.valid === "false") ||
+          (status.value === "warnings" && row.dataset.warnings !== "0")) &&
+        (!text || row.textContent.toLowerCase().indexOf(text) >= 0);
+      row.style.display = visible ? "" : "none";
+      if (visible) { shown++; }
+    });
+    count.textContent = shown + " of " + rows.length + " objects";
+  }
+  [type, status].forEach(function (el) { el.addEventListener("change", filter); });
+  search.addEventListener("input", filter);
+  filter();
+})();
+</script>
+</body>
+</html>
+`))
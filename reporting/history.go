@@ -0,0 +1,367 @@
+package reporting
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const createHistorySQL = `
+PRAGMA journal_mode = WAL;
+PRAGMA busy_timeout = 5000;
+
+CREATE TABLE IF NOT EXISTS report_run (
+	"run_id" TEXT NOT NULL PRIMARY KEY,
+	"base_url" TEXT NOT NULL,
+	"generated_at" DATETIME NOT NULL,
+	"total_objects" INTEGER NOT NULL,
+	"valid_objects" INTEGER NOT NULL,
+	"invalid_objects" INTEGER NOT NULL,
+	"total_errors" INTEGER NOT NULL,
+	"total_warnings" INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS report_result (
+	"run_id" TEXT NOT NULL REFERENCES report_run ("run_id") ON DELETE CASCADE,
+	"object_type" TEXT NOT NULL,
+	"object_id" TEXT NOT NULL,
+	"seller" TEXT NOT NULL,
+	"valid" BOOLEAN NOT NULL,
+	"errors" INTEGER NOT NULL,
+	"warnings" INTEGER NOT NULL,
+	"error_codes" TEXT NOT NULL,
+	PRIMARY KEY ("run_id", "object_type", "object_id")
+);
+
+CREATE INDEX IF NOT EXISTS report_run_base_url ON report_run ("base_url", "generated_at");
+`
+
+// History stores the reports of the validation runs in a SQLite database, to compare them over time
+type History struct {
+	db *sqlx.DB
+}
+
+// RunSummary is a validation run stored in the history
+type RunSummary struct {
+	RunID          string    `db:"run_id" json:"run_id"`
+	BaseURL        string    `db:"base_url" json:"base_url"`
+	GeneratedAt    time.Time `db:"generated_at" json:"generated_at"`
+	TotalObjects   int       `db:"total_objects" json:"total_objects"`
+	ValidObjects   int       `db:"valid_objects" json:"valid_objects"`
+	InvalidObjects int       `db:"invalid_objects" json:"invalid_objects"`
+	TotalErrors    int       `db:"total_errors" json:"total_errors"`
+	TotalWarnings  int       `db:"total_warnings" json:"total_warnings"`
+}
+
+// storedResult is the result of the validation of an object, as stored in the history
+type storedResult struct {
+	RunID      string `db:"run_id"`
+	ObjectType string `db:"object_type"`
+	ObjectID   string `db:"object_id"`
+	Seller     string `db:"seller"`
+	Valid      bool   `db:"valid"`
+	Errors     int    `db:"errors"`
+	Warnings   int    `db:"warnings"`
+	ErrorCodes string `db:"error_codes"`
+}
+
+// OpenHistory opens the history database, creating it if it does not exist
+func OpenHistory(path string) (*History, error) {
+	db, err := sqlx.Connect("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database: %w", err)
+	}
+	if _, err := db.Exec(createHistorySQL); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create history tables: %w", err)
+	}
+	return &History{db: db}, nil
+}
+
+// Close closes the history database
+func (h *History) Close() error {
+	return h.db.Close()
+}
+
+// SaveReport stores the report with its run id, replacing a previous report with the same run id
+func (h *History) SaveReport(report *ValidationReport) error {
+	tx, err := h.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+	defer tx.Rollback()
+
+	stats := report.Statistics
+	run := RunSummary{
+		RunID:          report.RunID,
+		BaseURL:        report.Config.BaseURL,
+		GeneratedAt:    report.GeneratedAt.UTC(),
+		TotalObjects:   stats.TotalObjects,
+		ValidObjects:   stats.ValidObjects,
+		InvalidObjects: stats.InvalidObjects,
+		TotalErrors:    stats.TotalErrors,
+		TotalWarnings:  stats.TotalWarnings,
+	}
+	if _, err := tx.Exec(`DELETE FROM report_result WHERE run_id = ?`, run.RunID); err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+	if _, err := tx.NamedExec(`INSERT OR REPLACE INTO report_run (run_id, base_url, generated_at, total_objects, valid_objects, invalid_objects, total_errors, total_warnings)
+		VALUES (:run_id, :base_url, :generated_at, :total_objects, :valid_objects, :invalid_objects, :total_errors, :total_warnings)`, run); err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+
+	for _, result := range report.Results {
+		codes := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			codes[i] = e.Code
+		}
+		if _, err := tx.NamedExec(`INSERT OR REPLACE INTO report_result (run_id, object_type, object_id, seller, valid, errors, warnings, error_codes)
+			VALUES (:run_id, :object_type, :object_id, :seller, :valid, :errors, :warnings, :error_codes)`, storedResult{
+			RunID:      run.RunID,
+			ObjectType: result.ObjectType,
+			ObjectID:   result.ObjectID,
+			Seller:     result.Seller,
+			Valid:      result.Valid,
+			Errors:     len(result.Errors),
+			Warnings:   len(result.Warnings),
+			ErrorCodes: strings.Join(codes, " "),
+		}); err != nil {
+			return fmt.Errorf("failed to save result of %s: %w", result.ObjectID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+	return nil
+}
+
+// Runs returns the last runs stored for a server, or for all servers if baseURL is empty, the most recent first.
+// A limit of zero returns all of them.
+func (h *History) Runs(baseURL string, limit int) ([]RunSummary, error) {
+	query := `SELECT * FROM report_run WHERE (? = '' OR base_url = ?) ORDER BY generated_at DESC, run_id DESC`
+	args := []any{baseURL, baseURL}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	var runs []RunSummary
+	if err := h.db.Select(&runs, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list runs: %w", err)
+	}
+	return runs, nil
+}
+
+// Run returns a run stored in the history
+func (h *History) Run(runID string) (*RunSummary, error) {
+	var run RunSummary
+	err := h.db.Get(&run, `SELECT * FROM report_run WHERE run_id = ?`, runID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("run %s not found in the history", runID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get run %s: %w", runID, err)
+	}
+	return &run, nil
+}
+
+// ObjectChange is an object whose validity changed between two runs
+type ObjectChange struct {
+	ObjectType string `json:"object_type"`
+	ObjectID   string `json:"object_id"`
+	Seller     string `json:"seller,omitempty"`
+	ErrorCodes string `json:"error_codes,omitempty"`
+}
+
+// GroupTrend is the evolution of the objects of a group (an object type or a seller) over the runs
+type GroupTrend struct {
+	Group  string       `json:"group"`
+	Points []TrendPoint `json:"points"`
+}
+
+// TrendPoint holds the counts of the objects of a group in a run
+type TrendPoint struct {
+	RunID       string    `db:"run_id" json:"run_id"`
+	GeneratedAt time.Time `db:"generated_at" json:"generated_at"`
+	Group       string    `db:"grp" json:"-"`
+	Count       int       `db:"count" json:"count"`
+	Invalid     int       `db:"invalid" json:"invalid"`
+}
+
+// ReportDiff is the comparison of two runs
+type ReportDiff struct {
+	From *RunSummary `json:"from"`
+	To   *RunSummary `json:"to"`
+
+	// NewlyInvalid are the objects invalid in the last run which were valid or did not exist in the first one
+	NewlyInvalid []ObjectChange `json:"newly_invalid"`
+
+	// Fixed are the objects valid in the last run which were invalid in the first one
+	Fixed []ObjectChange `json:"fixed"`
+
+	// Removed are the objects invalid in the first run which are not in the last one
+	Removed []ObjectChange `json:"removed"`
+
+	// Trends of the objects by type and by seller, over the runs of the server up to the last one
+	TrendsByType   []GroupTrend `json:"trends_by_type"`
+	TrendsBySeller []GroupTrend `json:"trends_by_seller"`
+}
+
+// Diff compares two runs, and computes the trends over the last runs of the same server, up to maxRuns
+func (h *History) Diff(fromRunID, toRunID string, maxRuns int) (*ReportDiff, error) {
+	from, err := h.Run(fromRunID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.Run(toRunID)
+	if err != nil {
+		return nil, err
+	}
+	diff := &ReportDiff{From: from, To: to}
+
+	before, err := h.results(fromRunID)
+	if err != nil {
+		return nil, err
+	}
+	after, err := h.results(toRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, result := range after {
+		previous, existed := before[key]
+		if !result.Valid && (!existed || previous.Valid) {
+			diff.NewlyInvalid = append(diff.NewlyInvalid, result.change())
+		}
+		if result.Valid && existed && !previous.Valid {
+			diff.Fixed = append(diff.Fixed, previous.change())
+		}
+	}
+	for key, previous := range before {
+		if _, exists := after[key]; !exists && !previous.Valid {
+			diff.Removed = append(diff.Removed, previous.change())
+		}
+	}
+	for _, changes := range [][]ObjectChange{diff.NewlyInvalid, diff.Fixed, diff.Removed} {
+		sort.Slice(changes, func(i, j int) bool {
+			if changes[i].ObjectType != changes[j].ObjectType {
+				return changes[i].ObjectType < changes[j].ObjectType
+			}
+			return changes[i].ObjectID < changes[j].ObjectID
+		})
+	}
+
+	if diff.TrendsByType, err = h.trends(to, "object_type", maxRuns); err != nil {
+		return nil, err
+	}
+	if diff.TrendsBySeller, err = h.trends(to, "seller", maxRuns); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// results returns the results of a run, by object type and id
+func (h *History) results(runID string) (map[string]storedResult, error) {
+	var results []storedResult
+	if err := h.db.Select(&results, `SELECT * FROM report_result WHERE run_id = ?`, runID); err != nil {
+		return nil, fmt.Errorf("failed to get results of run %s: %w", runID, err)
+	}
+	byKey := make(map[string]storedResult, len(results))
+	for _, r := range results {
+		byKey[r.ObjectType+" "+r.ObjectID] = r
+	}
+	return byKey, nil
+}
+
+func (r storedResult) change() ObjectChange {
+	return ObjectChange{ObjectType: r.ObjectType, ObjectID: r.ObjectID, Seller: r.Seller, ErrorCodes: r.ErrorCodes}
+}
+
+// trends returns the counts of objects grouped by a column of the results, in the runs of the server of the last
+// run up to it, in chronological order
+func (h *History) trends(last *RunSummary, column string, maxRuns int) ([]GroupTrend, error) {
+	if maxRuns <= 0 {
+		maxRuns = -1
+	}
+	var points []TrendPoint
+	err := h.db.Select(&points, `
+		SELECT r.run_id, r.generated_at, res.`+column+` AS grp, COUNT(*) AS count,
+			SUM(CASE WHEN res.valid THEN 0 ELSE 1 END) AS invalid
+		FROM report_result res
+		INNER JOIN (
+			SELECT run_id, generated_at FROM report_run
+			WHERE base_url = ? AND generated_at <= ?
+			ORDER BY generated_at DESC LIMIT ?
+		) AS r ON r.run_id = res.run_id
+		GROUP BY r.run_id, res.`+column+`
+		ORDER BY grp, r.generated_at`, last.BaseURL, last.GeneratedAt, maxRuns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute trends by %s: %w", column, err)
+	}
+
+	var trends []GroupTrend
+	for _, p := range points {
+		if len(trends) == 0 || trends[len(trends)-1].Group != p.Group {
+			trends = append(trends, GroupTrend{Group: p.Group})
+		}
+		trends[len(trends)-1].Points = append(trends[len(trends)-1].Points, p)
+	}
+	return trends, nil
+}
+
+// WriteMarkdown writes the comparison of the runs in Markdown format
+func (d *ReportDiff) WriteMarkdown(w io.Writer) {
+	fmt.Fprintf(w, "# TMForum Validation Report Comparison\n\n")
+	fmt.Fprintf(w, "| | From | To |\n")
+	fmt.Fprintf(w, "|-|------|----|\n")
+	fmt.Fprintf(w, "| Run | `%s` | `%s` |\n", d.From.RunID, d.To.RunID)
+	fmt.Fprintf(w, "| Base URL | `%s` | `%s` |\n", d.From.BaseURL, d.To.BaseURL)
+	fmt.Fprintf(w, "| Generated | %s | %s |\n", d.From.GeneratedAt.Format("2006-01-02 15:04:05 UTC"), d.To.GeneratedAt.Format("2006-01-02 15:04:05 UTC"))
+	fmt.Fprintf(w, "| Total Objects | %d | %d |\n", d.From.TotalObjects, d.To.TotalObjects)
+	fmt.Fprintf(w, "| Invalid Objects | %d | %d |\n", d.From.InvalidObjects, d.To.InvalidObjects)
+	fmt.Fprintf(w, "| Total Errors | %d | %d |\n", d.From.TotalErrors, d.To.TotalErrors)
+	fmt.Fprintf(w, "| Total Warnings | %d | %d |\n\n", d.From.TotalWarnings, d.To.TotalWarnings)
+
+	writeChanges := func(title string, changes []ObjectChange) {
+		fmt.Fprintf(w, "## %s (%d)\n\n", title, len(changes))
+		if len(changes) == 0 {
+			fmt.Fprintf(w, "None\n\n")
+			return
+		}
+		fmt.Fprintf(w, "| Object Type | Object | Seller | Error Codes |\n")
+		fmt.Fprintf(w, "|-------------|--------|--------|-------------|\n")
+		for _, c := range changes {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", c.ObjectType, c.ObjectID, c.Seller, c.ErrorCodes)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	writeChanges("Newly Invalid Objects", d.NewlyInvalid)
+	writeChanges("Fixed Objects", d.Fixed)
+	writeChanges("Removed Invalid Objects", d.Removed)
+
+	writeTrends := func(title, group string, trends []GroupTrend) {
+		fmt.Fprintf(w, "## %s\n\n", title)
+		fmt.Fprintf(w, "Invalid objects of the total in each run, the oldest first.\n\n")
+		fmt.Fprintf(w, "| %s | Runs | Invalid / Total |\n", group)
+		fmt.Fprintf(w, "|------|------|-----------------|\n")
+		for _, trend := range trends {
+			name := trend.Group
+			if name == "" {
+				name = "(none)"
+			}
+			values := make([]string, len(trend.Points))
+			for i, p := range trend.Points {
+				values[i] = fmt.Sprintf("%d/%d", p.Invalid, p.Count)
+			}
+			fmt.Fprintf(w, "| %s | %d | %s |\n", name, len(trend.Points), strings.Join(values, " → "))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	writeTrends("Trend by Object Type", "Object Type", d.TrendsByType)
+	writeTrends("Trend by Seller", "Seller", d.TrendsBySeller)
+}
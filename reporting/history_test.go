@@ -0,0 +1,95 @@
+package reporting
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistoryDiff(t *testing.T) {
+	history, err := OpenHistory(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer history.Close()
+
+	config := &Config{BaseURL: "https://tmf.example.com"}
+	reporter := NewReporter(config)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	save := func(runID string, baseURL string, day int, results ...ValidationResult) {
+		report := &ValidationReport{
+			RunID:       runID,
+			Config:      &Config{BaseURL: baseURL},
+			Statistics:  reporter.calculateStatistics(results),
+			Results:     results,
+			GeneratedAt: start.AddDate(0, 0, day),
+		}
+		if err := history.SaveReport(report); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result := func(id, seller string, valid bool) ValidationResult {
+		r := ValidationResult{ObjectID: id, ObjectType: "productOffering", Seller: seller, Valid: valid}
+		if !valid {
+			r.Errors = []ValidationError{{Field: "href", Code: "MISSING_REQUIRED_FIELD"}}
+		}
+		return r
+	}
+
+	save("run1", config.BaseURL, 0, result("a", "did:elsi:1", true), result("b", "did:elsi:1", false), result("c", "did:elsi:2", false))
+	save("other", "https://other.example.com", 1, result("a", "did:elsi:1", false))
+	save("run2", config.BaseURL, 2, result("a", "did:elsi:1", false), result("b", "did:elsi:1", true), result("d", "did:elsi:2", false))
+
+	runs, err := history.Runs(config.BaseURL, 0)
+	if err != nil || len(runs) != 2 || runs[0].RunID != "run2" || runs[1].InvalidObjects != 2 {
+		t.Fatalf("Unexpected runs: %v %+v", err, runs)
+	}
+
+	diff, err := history.Diff("run1", "run2", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := func(changes []ObjectChange) string {
+		var ids []string
+		for _, c := range changes {
+			ids = append(ids, c.ObjectID)
+		}
+		return strings.Join(ids, ",")
+	}
+	if ids(diff.NewlyInvalid) != "a,d" || ids(diff.Fixed) != "b" || ids(diff.Removed) != "c" {
+		t.Errorf("Unexpected diff: newly invalid %s, fixed %s, removed %s", ids(diff.NewlyInvalid), ids(diff.Fixed), ids(diff.Removed))
+	}
+
+	// The trends only include the runs of the same server
+	if len(diff.TrendsByType) != 1 || len(diff.TrendsByType[0].Points) != 2 || diff.TrendsByType[0].Points[0].Invalid != 2 {
+		t.Errorf("Unexpected trends by type: %+v", diff.TrendsByType)
+	}
+	if len(diff.TrendsBySeller) != 2 || diff.TrendsBySeller[0].Group != "did:elsi:1" || diff.TrendsBySeller[0].Points[1].Invalid != 1 {
+		t.Errorf("Unexpected trends by seller: %+v", diff.TrendsBySeller)
+	}
+
+	var out bytes.Buffer
+	diff.WriteMarkdown(&out)
+	if !strings.Contains(out.String(), "## Fixed Objects (1)") || !strings.Contains(out.String(), "| did:elsi:1 | 2 | 1/2 → 1/2 |") {
+		t.Errorf("Unexpected Markdown:\n%s", out.String())
+	}
+
+	if _, err := history.Diff("run1", "missing", 10); err == nil {
+		t.Error("Expected error for unknown run")
+	}
+}
+
+func TestTMFObjectSeller(t *testing.T) {
+	v4 := NewTMFObjectFromMap(map[string]any{"relatedParty": []any{
+		map[string]any{"id": "did:elsi:buyer", "role": "Buyer"},
+		map[string]any{"id": "did:elsi:seller", "role": "Seller"},
+	}})
+	v5 := NewTMFObjectFromMap(map[string]any{"relatedParty": []any{
+		map[string]any{"role": "seller", "partyOrPartyRole": map[string]any{"id": "did:elsi:seller"}},
+	}})
+	if v4.Seller() != "did:elsi:seller" || v5.Seller() != "did:elsi:seller" || (TMFObject{}).Seller() != "" {
+		t.Errorf("Unexpected sellers: %q %q", v4.Seller(), v5.Seller())
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -21,10 +25,32 @@ func NewProxy(config *Config) (*Proxy, error) {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	rules := DefaultRules()
+	if config.RulesFile != "" {
+		var err error
+		if rules, err = LoadRulesFile(config.RulesFile); err != nil {
+			return nil, err
+		}
+	}
+	if config.RulesDir != "" {
+		scripts, err := LoadScripts(config.RulesDir)
+		if err != nil {
+			return nil, err
+		}
+		rules.Scripts = scripts
+	}
+
+	client := NewClient(config)
+	tokens, err := NewTokenSource(config.Auth, client.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	client.SetTokenSource(tokens)
+
 	return &Proxy{
 		config:    config,
-		client:    NewClient(config),
-		validator: NewValidator(config),
+		client:    client,
+		validator: NewValidatorWithRules(config, rules),
 		reporter:  NewReporter(config),
 	}, nil
 }
@@ -50,21 +76,27 @@ func (p *Proxy) Run(ctx context.Context) error {
 	// 	log.Printf("Server info retrieved successfully")
 	// }
 
-	// Process each object type
+	// Retrieve the objects of all the types in parallel
+	objectsByType, errs, err := p.retrieveAll(ctx, func(objectType string, n int, err error) {
+		if err == nil {
+			log.Printf("Retrieved %d %s objects", n, objectType)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Process each object type, in the order of the configuration
 	var allResults []ValidationResult
-	var allObjects []TMFObject
 
-	for _, objectType := range p.config.ObjectTypes {
+	for i, objectType := range p.config.ObjectTypes {
 		log.Printf("Processing object type: %s", objectType)
 
-		objects, err := p.client.GetObjects(ctx, objectType, p.config)
-		if err != nil {
-			log.Printf("Warning: Failed to retrieve %s objects: %v", objectType, err)
+		if errs[i] != nil {
+			log.Printf("Warning: Failed to retrieve %s objects: %v", objectType, errs[i])
 			continue
 		}
-
-		log.Printf("Retrieved %d %s objects", len(objects), objectType)
-		allObjects = append(allObjects, objects...)
+		objects := objectsByType[i]
 
 		// Validate objects
 		results := p.validator.ValidateObjects(objects, objectType)
@@ -102,11 +134,46 @@ func (p *Proxy) Run(ctx context.Context) error {
 	log.Printf("Total errors: %d", report.Statistics.TotalErrors)
 	log.Printf("Total warnings: %d", report.Statistics.TotalWarnings)
 	log.Printf("Processing time: %v", report.Statistics.Duration)
-	log.Printf("Report saved to: %s", p.config.ReportFile)
+	log.Printf("Report saved to: %s", strings.Join(p.reporter.ReportFiles(), ", "))
+
+	// Store the report in the history and compare it with the previous run
+	if p.config.HistoryDB != "" {
+		if err := p.saveHistory(report); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// saveHistory stores the report in the history database, and logs the changes since the previous run of the same server
+func (p *Proxy) saveHistory(report *ValidationReport) error {
+	history, err := OpenHistory(p.config.HistoryDB)
+	if err != nil {
+		return err
+	}
+	defer history.Close()
+
+	if err := history.SaveReport(report); err != nil {
+		return err
+	}
+	log.Printf("Report saved in history as run %s", report.RunID)
+
+	runs, err := history.Runs(p.config.BaseURL, 2)
+	if err != nil {
+		return err
+	}
+	if len(runs) < 2 || runs[0].RunID != report.RunID {
+		return nil
+	}
+	diff, err := history.Diff(runs[1].RunID, report.RunID, 1)
+	if err != nil {
+		return err
+	}
+	log.Printf("Since run %s: %d newly invalid objects, %d fixed objects", runs[1].RunID, len(diff.NewlyInvalid), len(diff.Fixed))
+	return nil
+}
+
 // RunWithProgress runs the validation process with progress reporting
 func (p *Proxy) RunWithProgress(ctx context.Context, progressChan chan<- ProgressUpdate) error {
 	defer close(progressChan)
@@ -143,38 +210,49 @@ func (p *Proxy) RunWithProgress(ctx context.Context, progressChan chan<- Progres
 		Timestamp: time.Now(),
 	}
 
-	// Process object types
-	var allResults []ValidationResult
+	// Retrieve the objects of all the types in parallel
 	totalObjectTypes := len(p.config.ObjectTypes)
+	var completed atomic.Int32
 
-	for i, objectType := range p.config.ObjectTypes {
-		progress := 20 + (i * 60 / totalObjectTypes)
+	progressChan <- ProgressUpdate{
+		Stage:     "Processing",
+		Message:   fmt.Sprintf("Retrieving %d object types", totalObjectTypes),
+		Progress:  20,
+		Timestamp: time.Now(),
+	}
 
+	objectsByType, errs, err := p.retrieveAll(ctx, func(objectType string, n int, err error) {
+		done := int(completed.Add(1))
+		message := fmt.Sprintf("Completed %s: %d objects processed", objectType, n)
+		if err != nil {
+			message = fmt.Sprintf("Failed to retrieve %s objects: %v", objectType, err)
+		}
 		progressChan <- ProgressUpdate{
 			Stage:     "Processing",
-			Message:   fmt.Sprintf("Processing %s objects", objectType),
-			Progress:  progress,
+			Message:   message,
+			Progress:  20 + (done * 60 / totalObjectTypes),
 			Timestamp: time.Now(),
 		}
+	})
+	if err != nil {
+		progressChan <- ProgressUpdate{
+			Stage:     "Error",
+			Message:   err.Error(),
+			Progress:  0,
+			Timestamp: time.Now(),
+		}
+		return err
+	}
 
-		fmt.Printf("################# Processing %s objects\n", objectType)
-
-		objects, err := p.client.GetObjects(ctx, objectType, p.config)
-		if err != nil {
-			log.Printf("Warning: Failed to retrieve %s objects: %v", objectType, err)
+	// Validate the objects of each type, in the order of the configuration
+	var allResults []ValidationResult
+	for i, objectType := range p.config.ObjectTypes {
+		if errs[i] != nil {
+			log.Printf("Warning: Failed to retrieve %s objects: %v", objectType, errs[i])
 			continue
 		}
-
-		// Validate objects
-		results := p.validator.ValidateObjects(objects, objectType)
+		results := p.validator.ValidateObjects(objectsByType[i], objectType)
 		allResults = append(allResults, results...)
-
-		progressChan <- ProgressUpdate{
-			Stage:     "Processing",
-			Message:   fmt.Sprintf("Completed %s: %d objects processed", objectType, len(objects)),
-			Progress:  progress + (60 / totalObjectTypes),
-			Timestamp: time.Now(),
-		}
 	}
 
 	// Generate report
@@ -196,6 +274,18 @@ func (p *Proxy) RunWithProgress(ctx context.Context, progressChan chan<- Progres
 		return fmt.Errorf("failed to generate report: %w", err)
 	}
 
+	if p.config.HistoryDB != "" {
+		if err := p.saveHistory(report); err != nil {
+			progressChan <- ProgressUpdate{
+				Stage:     "Error",
+				Message:   fmt.Sprintf("Saving the report in the history failed: %v", err),
+				Progress:  0,
+				Timestamp: time.Now(),
+			}
+			return err
+		}
+	}
+
 	progressChan <- ProgressUpdate{
 		Stage: "Complete",
 		Message: fmt.Sprintf("Validation complete: %d objects, %d errors, %d warnings",
@@ -207,6 +297,52 @@ func (p *Proxy) RunWithProgress(ctx context.Context, progressChan chan<- Progres
 	return nil
 }
 
+// retrieveAll retrieves the objects of all the types in parallel, returning the objects and the error of each type
+// in the order of the configuration, so the reports do not depend on the order in which the requests complete.
+// The done function is called when each type is retrieved, from different goroutines.
+// If a checkpoint file is configured, the pages retrieved are recorded in it, and it is deleted when all the types
+// have been retrieved.
+func (p *Proxy) retrieveAll(ctx context.Context, done func(objectType string, n int, err error)) ([][]TMFObject, []error, error) {
+	var checkpoint *Checkpoint
+	if p.config.CheckpointFile != "" {
+		var err error
+		checkpoint, err = OpenCheckpoint(p.config.CheckpointFile, p.config.BaseURL, p.config.PageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n := checkpoint.Pages(); n > 0 {
+			log.Printf("Resuming from checkpoint %s with %d pages retrieved", p.config.CheckpointFile, n)
+		}
+		p.client.SetCheckpoint(checkpoint)
+		defer p.client.SetCheckpoint(nil)
+	}
+
+	objectsByType := make([][]TMFObject, len(p.config.ObjectTypes))
+	errs := make([]error, len(p.config.ObjectTypes))
+
+	var wg sync.WaitGroup
+	for i, objectType := range p.config.ObjectTypes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			objectsByType[i], errs[i] = p.client.GetObjects(ctx, objectType, p.config)
+			done(objectType, len(objectsByType[i]), errs[i])
+		}()
+	}
+	wg.Wait()
+
+	if checkpoint != nil {
+		if ctx.Err() != nil || slices.ContainsFunc(errs, func(err error) bool { return err != nil }) {
+			log.Printf("Checkpoint saved in %s to resume the run", p.config.CheckpointFile)
+			checkpoint.Close()
+		} else if err := checkpoint.Remove(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return objectsByType, errs, ctx.Err()
+}
+
 // ProgressUpdate represents a progress update during the validation process
 type ProgressUpdate struct {
 	Stage     string    `json:"stage"`
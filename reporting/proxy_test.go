@@ -2,6 +2,9 @@ package reporting
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -321,3 +324,67 @@ func TestConfigVersionConstants(t *testing.T) {
 		t.Errorf("Expected VersionV5 to be 'v5', got %s", VersionV5)
 	}
 }
+
+func TestValidateForStatus(t *testing.T) {
+	validator := NewValidator(&Config{ValidateRequiredFields: true})
+
+	obj := NewTMFObjectFromMap(map[string]any{
+		"id":                   "urn:ngsi-ld:product-offering:1",
+		"lifecycleStatus":      "In design",
+		"productOfferingPrice": []any{},
+		"productSpecification": map[string]any{"id": "urn:ngsi-ld:product-specification:1"},
+		"category":             []any{map[string]any{"id": "urn:ngsi-ld:category:1"}, map[string]any{"name": "Storage"}},
+	})
+
+	result := validator.ValidateForStatus(obj, "productOffering", "Launched")
+	var fields []string
+	for _, e := range result.Errors {
+		fields = append(fields, e.Field)
+	}
+	expected := []string{"productOfferingPrice", "category[1].id", "validFor"}
+	if result.Valid || strings.Join(fields, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected missing fields %v, got %v", expected, fields)
+	}
+
+	// Objects in other states do not require the fields
+	if result := validator.ValidateForStatus(obj, "productOffering", "In design"); !result.Valid {
+		t.Errorf("Expected valid object in design, got %v", result.Errors)
+	}
+}
+
+func TestLoadConfigFromFile(t *testing.T) {
+	for _, file := range []string{"config.example.json", "config.example.yaml"} {
+		config := DefaultConfig()
+		if err := config.LoadConfigFromFile(file); err != nil {
+			t.Fatalf("Failed to load %s: %v", file, err)
+		}
+		if config.Timeout != 30 || config.Version != VersionV4 || len(config.ObjectTypes) != 10 {
+			t.Errorf("Unexpected config from %s: %+v", file, config)
+		}
+	}
+
+	// Unknown settings are rejected
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"bad.json": `{"base_url": "https://tmf.example.com", "time_out": 10}`,
+		"bad.yaml": "base_url: https://tmf.example.com\ntime_out: 10\n",
+	} {
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)
+		if err := DefaultConfig().LoadConfigFromFile(filepath.Join(dir, name)); err == nil {
+			t.Errorf("Expected error for unknown setting in %s", name)
+		}
+	}
+
+	// The environment overrides the file
+	file := filepath.Join(dir, "config.yaml")
+	os.WriteFile(file, []byte("timeout: 10\npage_size: 50\n"), 0o644)
+	t.Setenv("TMF_TIMEOUT", "60")
+	config := DefaultConfig()
+	if err := config.LoadConfigFromFile(file); err != nil {
+		t.Fatal(err)
+	}
+	config.LoadConfigFromEnv()
+	if config.Timeout != 60 || config.PageSize != 50 || config.MaxObjects != 10000 {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+}
@@ -2,11 +2,14 @@ package reporting
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // Reporter generates validation reports and statistics
@@ -32,20 +35,78 @@ func (r *Reporter) GenerateReport(results []ValidationResult) (*ValidationReport
 	stats.Duration = stats.EndTime.Sub(stats.StartTime)
 
 	report := &ValidationReport{
-		Config:      r.config,
+		RunID:       uuid.Must(uuid.NewV7()).String(),
+		Config:      r.config.Redacted(),
 		Statistics:  stats,
 		Results:     results,
 		GeneratedAt: time.Now(),
 	}
 
-	// Generate Markdown report
-	if err := r.generateMarkdownReport(report); err != nil {
-		return nil, fmt.Errorf("failed to generate markdown report: %w", err)
+	// Ensure output directory exists
+	if err := os.MkdirAll(r.config.OutputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	// Generate the report in each of the formats configured
+	for _, format := range r.formats() {
+		if err := r.generateReportFile(report, format, r.reportPath(format)); err != nil {
+			return nil, fmt.Errorf("failed to generate %s report: %w", format, err)
+		}
+	}
+
+	// Generate a report for each seller, to send them their own results
+	if r.config.ReportBySeller {
+		if _, err := r.GenerateSellerReports(report); err != nil {
+			return nil, err
+		}
 	}
 
 	return report, nil
 }
 
+// formats returns the formats of the reports to generate, Markdown if none is configured
+func (r *Reporter) formats() []string {
+	if len(r.config.ReportFormats) == 0 {
+		return []string{FormatMarkdown}
+	}
+	return r.config.ReportFormats
+}
+
+// ReportFiles returns the paths of the report files generated, one for each format configured
+func (r *Reporter) ReportFiles() []string {
+	var files []string
+	for _, format := range r.formats() {
+		files = append(files, r.reportPath(format))
+	}
+	if r.config.ReportBySeller {
+		for _, format := range r.sellerFormats() {
+			files = append(files, r.sellerIndexPath(format))
+		}
+	}
+	return files
+}
+
+// reportPath returns the path of the report file in a format, which is the configured report file
+// with the extension of the format
+func (r *Reporter) reportPath(format string) string {
+	name := strings.TrimSuffix(r.config.ReportFile, filepath.Ext(r.config.ReportFile))
+	return filepath.Join(r.config.OutputDir, name+reportExtensions[format])
+}
+
+// generateReportFile writes the report to a file in a format
+func (r *Reporter) generateReportFile(report *ValidationReport, format, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer file.Close()
+
+	if err := r.WriteReport(file, report, format); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
 // calculateStatistics calculates statistics from validation results
 func (r *Reporter) calculateStatistics(results []ValidationResult) *Statistics {
 	stats := &Statistics{
@@ -93,20 +154,8 @@ func (r *Reporter) calculateStatistics(results []ValidationResult) *Statistics {
 	return stats
 }
 
-// generateMarkdownReport generates a Markdown report file
-func (r *Reporter) generateMarkdownReport(report *ValidationReport) error {
-	// Ensure output directory exists
-	if err := os.MkdirAll(r.config.OutputDir, 0755); err != nil {
-		return fmt.Errorf("failed to create output directory: %w", err)
-	}
-
-	filePath := filepath.Join(r.config.OutputDir, r.config.ReportFile)
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to create report file: %w", err)
-	}
-	defer file.Close()
-
+// writeMarkdownReport writes the report in Markdown format
+func (r *Reporter) writeMarkdownReport(file io.Writer, report *ValidationReport) error {
 	// Write report header
 	r.writeReportHeader(file, report)
 
@@ -129,9 +178,13 @@ func (r *Reporter) generateMarkdownReport(report *ValidationReport) error {
 }
 
 // writeReportHeader writes the report header
-func (r *Reporter) writeReportHeader(file *os.File, report *ValidationReport) {
+func (r *Reporter) writeReportHeader(file io.Writer, report *ValidationReport) {
 	fmt.Fprintf(file, "# TMForum Object Validation Report\n\n")
 	fmt.Fprintf(file, "**Generated:** %s\n\n", report.GeneratedAt.Format("2006-01-02 15:04:05 UTC"))
+	fmt.Fprintf(file, "**Run:** `%s`\n\n", report.RunID)
+	if report.Seller != "" {
+		fmt.Fprintf(file, "**Seller:** `%s`\n\n", report.Seller)
+	}
 	fmt.Fprintf(file, "**Configuration:**\n")
 	fmt.Fprintf(file, "- Base URL: `%s`\n", report.Config.BaseURL)
 	fmt.Fprintf(file, "- Object Types: %s\n", strings.Join(report.Config.ObjectTypes, ", "))
@@ -141,7 +194,7 @@ func (r *Reporter) writeReportHeader(file *os.File, report *ValidationReport) {
 }
 
 // writeSummaryStatistics writes the summary statistics
-func (r *Reporter) writeSummaryStatistics(file *os.File, stats *Statistics) {
+func (r *Reporter) writeSummaryStatistics(file io.Writer, stats *Statistics) {
 	fmt.Fprintf(file, "## Summary Statistics\n\n")
 	fmt.Fprintf(file, "| Metric | Value |\n")
 	fmt.Fprintf(file, "|--------|-------|\n")
@@ -154,7 +207,7 @@ func (r *Reporter) writeSummaryStatistics(file *os.File, stats *Statistics) {
 }
 
 // writeDetailedStatistics writes detailed statistics by object type
-func (r *Reporter) writeDetailedStatistics(file *os.File, stats *Statistics) {
+func (r *Reporter) writeDetailedStatistics(file io.Writer, stats *Statistics) {
 	fmt.Fprintf(file, "## Statistics by Object Type\n\n")
 	fmt.Fprintf(file, "| Object Type | Count | Valid | Invalid | Errors | Warnings |\n")
 	fmt.Fprintf(file, "|-------------|-------|-------|---------|--------|----------|\n")
@@ -175,7 +228,7 @@ func (r *Reporter) writeDetailedStatistics(file *os.File, stats *Statistics) {
 }
 
 // writeErrorWarningSummary writes error and warning summary
-func (r *Reporter) writeErrorWarningSummary(file *os.File, stats *Statistics) {
+func (r *Reporter) writeErrorWarningSummary(file io.Writer, stats *Statistics) {
 	if stats.TotalErrors > 0 {
 		fmt.Fprintf(file, "## Error Summary\n\n")
 		fmt.Fprintf(file, "| Error Code | Count |\n")
@@ -212,7 +265,7 @@ func (r *Reporter) writeErrorWarningSummary(file *os.File, stats *Statistics) {
 }
 
 // writeDetailedResults writes detailed validation results
-func (r *Reporter) writeDetailedResults(file *os.File, results []ValidationResult) {
+func (r *Reporter) writeDetailedResults(file io.Writer, results []ValidationResult) {
 	fmt.Fprintf(file, "## Detailed Validation Results\n\n")
 
 	// Group results by object type
@@ -258,7 +311,7 @@ func (r *Reporter) writeDetailedResults(file *os.File, results []ValidationResul
 }
 
 // writeReportFooter writes the report footer
-func (r *Reporter) writeReportFooter(file *os.File, report *ValidationReport) {
+func (r *Reporter) writeReportFooter(file io.Writer, report *ValidationReport) {
 	fmt.Fprintf(file, "---\n\n")
 	fmt.Fprintf(file, "*Report generated by TMForum Proxy Validator*\n")
 	fmt.Fprintf(file, "*Generated at: %s*\n", report.GeneratedAt.Format("2006-01-02 15:04:05 UTC"))
@@ -0,0 +1,83 @@
+package reporting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateReportFormats(t *testing.T) {
+	config := DefaultConfig()
+	config.OutputDir = t.TempDir()
+	config.ReportFile = "report.md"
+	config.ReportFormats = ReportFormats()
+
+	results := []ValidationResult{
+		{ObjectID: "urn:ngsi-ld:product-offering:1", ObjectType: "productOffering", Valid: true, Timestamp: time.Now()},
+		{
+			ObjectID: "urn:ngsi-ld:category:1", ObjectType: "category", Valid: false, Timestamp: time.Now(),
+			Errors:   []ValidationError{{Field: "href", Message: "Required field 'href' is missing", Code: "MISSING_REQUIRED_FIELD"}},
+			Warnings: []ValidationWarning{{Field: "name", Message: "Name <b>is</b> empty", Code: "EMPTY_NAME"}},
+		},
+	}
+
+	reporter := NewReporter(config)
+	if _, err := reporter.GenerateReport(results); err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	files := reporter.ReportFiles()
+	if len(files) != 6 || files[0] != filepath.Join(config.OutputDir, "report.csv") {
+		t.Fatalf("Unexpected report files: %v", files)
+	}
+	read := func(ext string) []byte {
+		content, err := os.ReadFile(filepath.Join(config.OutputDir, "report"+ext))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return content
+	}
+
+	var report ValidationReport
+	if err := json.Unmarshal(read(".json"), &report); err != nil || len(report.Results) != 2 || report.Statistics.InvalidObjects != 1 {
+		t.Errorf("Unexpected JSON report: %v %+v", err, report)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(read(".csv")))).ReadAll()
+	if err != nil || len(rows) != 3 || rows[1][0] != "category" || rows[1][5] != "MISSING_REQUIRED_FIELD" {
+		t.Errorf("Unexpected CSV report: %v %v", err, rows)
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(read(".junit.xml"), &suites); err != nil || suites.Tests != 2 || suites.Failures != 1 || len(suites.Suites) != 2 {
+		t.Errorf("Unexpected JUnit report: %v %+v", err, suites)
+	} else if failure := suites.Suites[0].Cases[0].Failure; failure == nil || !strings.Contains(failure.Text, "href") {
+		t.Errorf("Unexpected JUnit failure: %+v", suites.Suites[0].Cases[0])
+	}
+
+	var sarif sarifLog
+	if err := json.Unmarshal(read(".sarif"), &sarif); err != nil || sarif.Version != "2.1.0" || len(sarif.Runs[0].Results) != 2 || len(sarif.Runs[0].Tool.Driver.Rules) != 2 {
+		t.Errorf("Unexpected SARIF report: %v %+v", err, sarif)
+	} else if sarif.Runs[0].Results[1].Level != "warning" {
+		t.Errorf("Unexpected SARIF result: %+v", sarif.Runs[0].Results[1])
+	}
+
+	// The HTML report is self-contained and escapes the values
+	html := string(read(".html"))
+	if !strings.Contains(html, "urn:ngsi-ld:category:1") || !strings.Contains(html, "Name &lt;b&gt;is&lt;/b&gt; empty") || strings.Contains(html, " src=") {
+		t.Errorf("Unexpected HTML report:\n%s", html)
+	}
+
+	if !strings.Contains(string(read(".md")), "#### Object: urn:ngsi-ld:category:1") {
+		t.Errorf("Unexpected Markdown report")
+	}
+
+	config.ReportFormats = []string{"pdf"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unknown report format")
+	}
+}
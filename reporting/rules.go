@@ -0,0 +1,295 @@
+package reporting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Severity of the problems found by a rule
+type Severity string
+
+const (
+	// SeverityError makes the object invalid
+	SeverityError Severity = "error"
+	// SeverityWarning is reported but does not make the object invalid
+	SeverityWarning Severity = "warning"
+)
+
+// Rules is the set of requirements checked by the Validator. The first sections have the same meaning as the
+// variables with the same name in this package, which are their default values. Rules are the additional
+// checks defined by the marketplace, declared in the file or written as scripts.
+type Rules struct {
+	RequiredFieldsForAllObjects []string                       `json:"required_fields_for_all_objects" yaml:"required_fields_for_all_objects"`
+	RequiredFields              map[string][]string            `json:"required_fields" yaml:"required_fields"`
+	RequiredFieldsForStatus     map[string]map[string][]string `json:"required_fields_for_status" yaml:"required_fields_for_status"`
+	RequiredRelatedPartyRoles   map[string][]string            `json:"required_related_party_roles" yaml:"required_related_party_roles"`
+	DoNotRequireRelatedParties  []string                       `json:"do_not_require_related_parties" yaml:"do_not_require_related_parties"`
+	DoNotRequireBuyerInfo       []string                       `json:"do_not_require_buyer_info" yaml:"do_not_require_buyer_info"`
+	Rules                       []Rule                         `json:"rules" yaml:"rules"`
+
+	// Scripts are the rules written in Starlark, loaded with LoadScripts
+	Scripts []*Script `json:"-" yaml:"-"`
+}
+
+// Rule checks the values in a path of the objects. Paths are the names of the fields separated by dots, like
+// "validFor.startDateTime", and when a field is a list the rest of the path is checked in every element.
+type Rule struct {
+	// Name identifies the rule in the problems reported, and Code is their code
+	Name string `json:"name" yaml:"name"`
+	Code string `json:"code,omitempty" yaml:"code,omitempty"`
+
+	// ObjectTypes the rule applies to, or all if empty
+	ObjectTypes []string `json:"object_types,omitempty" yaml:"object_types,omitempty"`
+
+	// When are the conditions on other fields of the object for the rule to apply, all of which must hold
+	When []Condition `json:"when,omitempty" yaml:"when,omitempty"`
+
+	// Path checked, which must have a value that is not empty if Required is set.
+	// The values found must be in AllowedValues and match Pattern, if specified.
+	Path          string   `json:"path" yaml:"path"`
+	Required      bool     `json:"required,omitempty" yaml:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty" yaml:"allowed_values,omitempty"`
+	Pattern       string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+
+	// Severity of the problems found, error by default, and the Message reported instead of the default one
+	Severity Severity `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Message  string   `json:"message,omitempty" yaml:"message,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Condition on a field of the object. If In is specified, one of the values in the path must be in the list.
+// Otherwise, the path must have a value that is not empty, or not have it if Exists is false.
+type Condition struct {
+	Path   string   `json:"path" yaml:"path"`
+	In     []string `json:"in,omitempty" yaml:"in,omitempty"`
+	Exists *bool    `json:"exists,omitempty" yaml:"exists,omitempty"`
+}
+
+// DefaultRules returns the rules equivalent to the variables of this package, without additional rules
+func DefaultRules() *Rules {
+	forStatus := make(map[string]map[string][]string, len(RequiredFieldsForStatus))
+	for objectType, statuses := range RequiredFieldsForStatus {
+		forStatus[objectType] = maps.Clone(statuses)
+	}
+	return &Rules{
+		RequiredFieldsForAllObjects: slices.Clone(RequiredFieldsForAllObjects),
+		RequiredFields:              maps.Clone(RequiredFields),
+		RequiredFieldsForStatus:     forStatus,
+		RequiredRelatedPartyRoles:   maps.Clone(RequiredRelatedPartyRoles),
+		DoNotRequireRelatedParties:  slices.Clone(DoNotRequireRelatedParties),
+		DoNotRequireBuyerInfo:       slices.Clone(DoNotRequireBuyerInfo),
+	}
+}
+
+// LoadRulesFile reads the rules from a YAML (or JSON) file. The sections not present in the file keep their
+// default values, so a file with only some additional rules extends the default requirements.
+func LoadRulesFile(path string) (*Rules, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+	rules, err := ParseRules(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// ParseRules parses the rules in YAML (or JSON) format, completing them with the defaults.
+// Unknown fields are rejected, to detect typos in the names of the sections.
+func ParseRules(content []byte) (*Rules, error) {
+	var rules Rules
+	if err := yaml.NewDecoder(bytes.NewReader(content), yaml.Strict()).Decode(&rules); err != nil {
+		return nil, err
+	}
+
+	defaults := DefaultRules()
+	if rules.RequiredFieldsForAllObjects == nil {
+		rules.RequiredFieldsForAllObjects = defaults.RequiredFieldsForAllObjects
+	}
+	if rules.RequiredFields == nil {
+		rules.RequiredFields = defaults.RequiredFields
+	}
+	if rules.RequiredFieldsForStatus == nil {
+		rules.RequiredFieldsForStatus = defaults.RequiredFieldsForStatus
+	}
+	if rules.RequiredRelatedPartyRoles == nil {
+		rules.RequiredRelatedPartyRoles = defaults.RequiredRelatedPartyRoles
+	}
+	if rules.DoNotRequireRelatedParties == nil {
+		rules.DoNotRequireRelatedParties = defaults.DoNotRequireRelatedParties
+	}
+	if rules.DoNotRequireBuyerInfo == nil {
+		rules.DoNotRequireBuyerInfo = defaults.DoNotRequireBuyerInfo
+	}
+
+	for i := range rules.Rules {
+		if err := rules.Rules[i].compile(); err != nil {
+			return nil, fmt.Errorf("rule %d (%s): %w", i, rules.Rules[i].Name, err)
+		}
+	}
+	return &rules, nil
+}
+
+// compile checks the rule and prepares it to be applied
+func (r *Rule) compile() error {
+	if r.Path == "" {
+		return fmt.Errorf("path is required")
+	}
+	if !r.Required && len(r.AllowedValues) == 0 && r.Pattern == "" {
+		return fmt.Errorf("at least one of required, allowed_values or pattern must be specified")
+	}
+	switch r.Severity {
+	case "":
+		r.Severity = SeverityError
+	case SeverityError, SeverityWarning:
+	default:
+		return fmt.Errorf("invalid severity %q, expected error or warning", r.Severity)
+	}
+	for _, c := range r.When {
+		if c.Path == "" {
+			return fmt.Errorf("path is required in conditions")
+		}
+	}
+	if r.Pattern != "" {
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+		r.pattern = pattern
+	}
+	return nil
+}
+
+// applies reports whether the rule must be checked in the object
+func (r *Rule) applies(obj map[string]any, objectType string) bool {
+	if len(r.ObjectTypes) > 0 && !slices.Contains(r.ObjectTypes, objectType) {
+		return false
+	}
+	for _, c := range r.When {
+		if !c.holds(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds reports whether the condition is satisfied by the object
+func (c Condition) holds(obj map[string]any) bool {
+	values := valuesAt(obj, c.Path)
+	if len(c.In) > 0 {
+		for _, v := range values {
+			if slices.Contains(c.In, v.value) {
+				return true
+			}
+		}
+		return false
+	}
+	exists := len(missingAt(obj, c.Path)) == 0
+	return exists == (c.Exists == nil || *c.Exists)
+}
+
+// problem is a problem found by a rule in a field of an object
+type problem struct {
+	field    string
+	code     string
+	message  string
+	severity Severity
+}
+
+// check returns the problems found by the rule in the object
+func (r *Rule) check(obj map[string]any) []problem {
+	var problems []problem
+	report := func(field, code, message string) {
+		if r.Code != "" {
+			code = r.Code
+		}
+		if r.Message != "" {
+			message = r.Message
+		}
+		if r.Name != "" {
+			message = r.Name + ": " + message
+		}
+		problems = append(problems, problem{field: field, code: code, message: message, severity: r.Severity})
+	}
+
+	if r.Required {
+		for _, field := range missingAt(obj, r.Path) {
+			report(field, "MISSING_REQUIRED_FIELD", fmt.Sprintf("Required field '%s' is missing", field))
+		}
+	}
+	for _, v := range valuesAt(obj, r.Path) {
+		if len(r.AllowedValues) > 0 && !slices.Contains(r.AllowedValues, v.value) {
+			report(v.field, "INVALID_VALUE", fmt.Sprintf("Value '%s' of field '%s' is not one of %s", v.value, v.field, strings.Join(r.AllowedValues, ", ")))
+		}
+		if r.pattern != nil && !r.pattern.MatchString(v.value) {
+			report(v.field, "PATTERN_MISMATCH", fmt.Sprintf("Value '%s' of field '%s' does not match '%s'", v.value, v.field, r.Pattern))
+		}
+	}
+	return problems
+}
+
+// fieldValue is a value found in a path of an object, with the name of the field including the list indexes
+type fieldValue struct {
+	field string
+	value string
+}
+
+// missingAt returns the fields in the path which are missing or empty in the object
+func missingAt(obj map[string]any, path string) []string {
+	parts := strings.Split(path, ".")
+	return missingFields(obj[parts[0]], parts[1:], parts[0])
+}
+
+// valuesAt returns the scalar values in the path of the object, as strings
+func valuesAt(obj map[string]any, path string) []fieldValue {
+	var values []fieldValue
+	var walk func(value any, path []string, field string)
+	walk = func(value any, path []string, field string) {
+		switch v := value.(type) {
+		case nil:
+		case []any:
+			for i, elem := range v {
+				walk(elem, path, fmt.Sprintf("%s[%d]", field, i))
+			}
+		case map[string]any:
+			if len(path) > 0 {
+				walk(v[path[0]], path[1:], field+"."+path[0])
+			}
+		default:
+			if len(path) == 0 {
+				values = append(values, fieldValue{field: field, value: fmt.Sprint(v)})
+			}
+		}
+	}
+	parts := strings.Split(path, ".")
+	walk(obj[parts[0]], parts[1:], parts[0])
+	return values
+}
+
+// asMap returns the generic representation of the object, which is used to apply the rules
+func (obj TMFObject) asMap() map[string]any {
+	m := make(map[string]any, len(obj.AdditionalFields)+6)
+	maps.Copy(m, obj.AdditionalFields)
+	for key, value := range map[string]string{"id": obj.ID, "href": obj.Href, "lastUpdate": obj.LastUpdate, "version": obj.Version, "@type": obj.Type} {
+		if value != "" {
+			m[key] = value
+		}
+	}
+	if len(obj.RelatedParty) > 0 {
+		var relatedParty any
+		if err := json.Unmarshal(obj.RelatedParty, &relatedParty); err == nil {
+			m["relatedParty"] = relatedParty
+		}
+	}
+	return m
+}
@@ -0,0 +1,76 @@
+package reporting
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRulesExampleFile(t *testing.T) {
+	rules, err := LoadRulesFile("rules.example.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load example rules: %v", err)
+	}
+
+	// The sections in the example are the defaults
+	defaults := DefaultRules()
+	rules.Rules, defaults.Rules = nil, nil
+	if !reflect.DeepEqual(rules, defaults) {
+		t.Errorf("Example rules differ from the defaults:\n%+v\n%+v", rules, defaults)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+do_not_require_related_parties: []
+rules:
+  - name: Country
+    object_types: [organization]
+    when:
+      - path: partyCharacteristic.name
+        in: [country]
+    path: partyCharacteristic.value
+    pattern: "^[A-Z]{2}$"
+  - path: description
+    required: true
+    severity: warning
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules.DoNotRequireRelatedParties) != 0 || !reflect.DeepEqual(rules.RequiredFields, RequiredFields) {
+		t.Errorf("Unexpected sections: %+v", rules)
+	}
+
+	validator := NewValidatorWithRules(&Config{}, rules)
+	obj := NewTMFObjectFromMap(map[string]any{
+		"id": "urn:ngsi-ld:organization:1",
+		"partyCharacteristic": []any{
+			map[string]any{"name": "country", "value": "Spain"},
+		},
+	})
+	result := validator.ValidateObject(obj, "organization")
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != "PATTERN_MISMATCH" || result.Errors[0].Field != "partyCharacteristic[0].value" {
+		t.Errorf("Unexpected errors: %+v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Code != "MISSING_REQUIRED_FIELD" || !strings.HasPrefix(result.Warnings[0].Message, "Required") {
+		t.Errorf("Unexpected warnings: %+v", result.Warnings)
+	}
+
+	// The conditions select the objects checked
+	obj = NewTMFObjectFromMap(map[string]any{"description": "ACME", "partyCharacteristic": []any{map[string]any{"name": "size", "value": "big"}}})
+	if result := validator.ValidateObject(obj, "organization"); !result.Valid || len(result.Warnings) != 0 {
+		t.Errorf("Unexpected problems: %+v %+v", result.Errors, result.Warnings)
+	}
+
+	for _, invalid := range []string{
+		"unknown_section: []",
+		"rules: [{path: name}]",
+		"rules: [{path: name, pattern: '('}]",
+		"rules: [{path: name, required: true, severity: fatal}]",
+	} {
+		if _, err := ParseRules([]byte(invalid)); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package reporting
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/pdp"
+	st "go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// maxScriptSteps limits the execution of a validate function for a single object, to stop endless loops
+const maxScriptSteps = 1000000
+
+// Script is a validation rule written in Starlark. The script must define a function validate(obj), or
+// validate(obj, object_type), which returns None or a list of the problems found in the object.
+// The problems are created with the builtins error(field, message, code="SCRIPT_ERROR") and
+// warning(field, message, code="SCRIPT_WARNING"), or can be strings, which are reported as errors.
+// The modules available to the policies of the PDP, like json, time and star, are available to the scripts.
+type Script struct {
+	Name     string
+	validate *st.Function
+}
+
+// scriptBuiltins are the functions predeclared for the scripts
+var scriptBuiltins = st.StringDict{
+	"error":   st.NewBuiltin("error", newScriptProblem(SeverityError, "SCRIPT_ERROR")),
+	"warning": st.NewBuiltin("warning", newScriptProblem(SeverityWarning, "SCRIPT_WARNING")),
+}
+
+// newScriptProblem returns the implementation of the builtins which create problems
+func newScriptProblem(severity Severity, defaultCode string) func(*st.Thread, *st.Builtin, st.Tuple, []st.Tuple) (st.Value, error) {
+	return func(_ *st.Thread, b *st.Builtin, args st.Tuple, kwargs []st.Tuple) (st.Value, error) {
+		var field, message string
+		code := defaultCode
+		if err := st.UnpackArgs(b.Name(), args, kwargs, "field", &field, "message", &message, "code?", &code); err != nil {
+			return nil, err
+		}
+		d := st.NewDict(4)
+		d.SetKey(st.String("field"), st.String(field))
+		d.SetKey(st.String("message"), st.String(message))
+		d.SetKey(st.String("code"), st.String(code))
+		d.SetKey(st.String("severity"), st.String(severity))
+		return d, nil
+	}
+}
+
+// LoadScripts loads the scripts (*.star files) in a directory, in alphabetical order
+func LoadScripts(dir string) ([]*Script, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.star"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules directory: %w", err)
+	}
+	sort.Strings(files)
+
+	var scripts []*Script
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rule script: %w", err)
+		}
+		script, err := NewScript(filepath.Base(file), src)
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, script)
+	}
+	return scripts, nil
+}
+
+// NewScript compiles the source code of a script
+func NewScript(name string, src []byte) (*Script, error) {
+	thread := &st.Thread{Name: "load " + name}
+	globals, err := st.ExecFileOptions(&syntax.FileOptions{}, thread, name, src, scriptBuiltins)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling rule script %s: %w", name, err)
+	}
+	globals.Freeze()
+
+	validate, ok := globals["validate"].(*st.Function)
+	if !ok {
+		return nil, fmt.Errorf("rule script %s does not define a validate function", name)
+	}
+	if n := validate.NumParams(); n < 1 || n > 2 {
+		return nil, fmt.Errorf("validate function of rule script %s must have one or two parameters, has %d", name, n)
+	}
+	return &Script{Name: name, validate: validate}, nil
+}
+
+// check runs the validate function of the script with the object. The compiled script is immutable,
+// so it can be called concurrently, with a new thread for each call.
+func (s *Script) check(obj map[string]any, objectType string) ([]problem, error) {
+	thread := &st.Thread{
+		Name: "validate " + s.Name,
+		Print: func(_ *st.Thread, msg string) {
+			log.Printf("%s: %s", s.Name, msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxScriptSteps)
+
+	args := st.Tuple{pdp.StarTMFMap(obj)}
+	if s.validate.NumParams() == 2 {
+		args = append(args, st.String(objectType))
+	}
+	result, err := st.Call(thread, s.validate, args, nil)
+	if err != nil {
+		return nil, err
+	}
+	if result == st.None {
+		return nil, nil
+	}
+
+	iterable, ok := result.(st.Iterable)
+	if !ok {
+		return nil, fmt.Errorf("validate returned %s, expected a list of problems", result.Type())
+	}
+	iter := iterable.Iterate()
+	defer iter.Done()
+
+	var problems []problem
+	var item st.Value
+	for iter.Next(&item) {
+		p := problem{code: "SCRIPT_ERROR", severity: SeverityError}
+		switch v := item.(type) {
+		case st.String:
+			p.message = string(v)
+		case *st.Dict:
+			p.field = dictString(v, "field")
+			p.message = dictString(v, "message")
+			if code := dictString(v, "code"); code != "" {
+				p.code = code
+			}
+			if severity := Severity(dictString(v, "severity")); severity == SeverityWarning {
+				p.severity = severity
+			}
+		default:
+			return nil, fmt.Errorf("validate returned a problem of type %s, expected a dict or a string", item.Type())
+		}
+		problems = append(problems, p)
+	}
+	return problems, nil
+}
+
+// dictString returns the string value of a key in a Starlark dict, or an empty string if it is not a string
+func dictString(d *st.Dict, key string) string {
+	value, found, _ := d.Get(st.String(key))
+	if !found {
+		return ""
+	}
+	if s, ok := value.(st.String); ok {
+		return string(s)
+	}
+	return strings.Trim(value.String(), `"`)
+}
@@ -0,0 +1,60 @@
+package reporting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScripts(t *testing.T) {
+	scripts, err := LoadScripts("example_rules")
+	if err != nil || len(scripts) != 1 {
+		t.Fatalf("Failed to load example scripts: %v %v", scripts, err)
+	}
+
+	rules := DefaultRules()
+	rules.Scripts = scripts
+	validator := NewValidatorWithRules(&Config{}, rules)
+
+	seller := func(id, name string) map[string]any {
+		return map[string]any{
+			"role":             "Seller",
+			"partyOrPartyRole": map[string]any{"id": id, "name": name},
+		}
+	}
+	obj := NewTMFObjectFromMap(map[string]any{
+		"id": "urn:ngsi-ld:product-offering:1",
+		"relatedParty": []any{
+			seller("urn:ngsi-ld:organization:did:elsi:VATES-11111111K", "did:elsi:VATES-11111111K"),
+			seller("urn:ngsi-ld:organization:did:elsi:VATES-22222222K", "did:elsi:VATES-11111111K"),
+			seller("urn:ngsi-ld:organization:ACME", "ACME"),
+			map[string]any{"role": "Seller", "partyOrPartyRole": map[string]any{"id": "urn:ngsi-ld:organization:ACME"}},
+			map[string]any{"partyOrPartyRole": map[string]any{}},
+		},
+	})
+	result := validator.ValidateObject(obj, "productOffering")
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != "SELLER_DID_MISMATCH" || result.Errors[0].Field != "relatedParty[1].partyOrPartyRole.id" {
+		t.Errorf("Unexpected errors: %+v", result.Errors)
+	}
+	if len(result.Warnings) != 2 || result.Warnings[0].Code != "SELLER_DID_FORMAT" || result.Warnings[1].Field != "relatedParty[3].partyOrPartyRole.name" {
+		t.Errorf("Unexpected warnings: %+v", result.Warnings)
+	}
+
+	// Scripts which fail are reported as warnings
+	script, err := NewScript("broken.star", []byte("def validate(obj):\n    return 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules.Scripts = []*Script{script}
+	result = validator.ValidateObject(obj, "productOffering")
+	if !result.Valid || len(result.Warnings) != 1 || result.Warnings[0].Code != "SCRIPT_FAILED" {
+		t.Errorf("Unexpected result for failing script: %+v", result)
+	}
+
+	// Scripts must define the validate function
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "empty.star"), []byte("x = 1\n"), 0o644)
+	if _, err := LoadScripts(dir); err == nil {
+		t.Errorf("Expected error for script without validate function")
+	}
+}
@@ -0,0 +1,206 @@
+package reporting
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// sellersDir is the subdirectory of the output directory with the reports of each seller
+const sellersDir = "sellers"
+
+// noSellerName is the name of the report with the objects without a Seller in their relatedParty
+const noSellerName = "no-seller"
+
+// SellerSummary describes the report of a seller, as listed in the index
+type SellerSummary struct {
+	Seller     string      `json:"seller"`
+	Statistics *Statistics `json:"statistics"`
+
+	// Files are the names of the report files of the seller, relative to the index, by format
+	Files map[string]string `json:"files"`
+}
+
+// sellerIndex is the index of the reports of the sellers
+type sellerIndex struct {
+	RunID       string          `json:"run_id"`
+	BaseURL     string          `json:"base_url"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Sellers     []SellerSummary `json:"sellers"`
+}
+
+// unsafeFileChars are the characters replaced in the names of the report files of the sellers
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// GroupBySeller groups the results by the Seller of the objects. The objects without a Seller are
+// grouped with an empty key.
+func GroupBySeller(results []ValidationResult) map[string][]ValidationResult {
+	groups := make(map[string][]ValidationResult)
+	for _, result := range results {
+		groups[result.Seller] = append(groups[result.Seller], result)
+	}
+	return groups
+}
+
+// sellerFormats returns the formats of the reports of the sellers, which are the formats configured
+// among Markdown, HTML and JSON, or Markdown if there are none of them
+func (r *Reporter) sellerFormats() []string {
+	var formats []string
+	for _, format := range r.formats() {
+		if format == FormatMarkdown || format == FormatHTML || format == FormatJSON {
+			formats = append(formats, format)
+		}
+	}
+	if len(formats) == 0 {
+		formats = []string{FormatMarkdown}
+	}
+	return formats
+}
+
+// sellerIndexPath returns the path of the index of the reports of the sellers in a format
+func (r *Reporter) sellerIndexPath(format string) string {
+	return filepath.Join(r.config.OutputDir, sellersDir, "index"+reportExtensions[format])
+}
+
+// GenerateSellerReports writes a report with the results of each seller in the subdirectory "sellers"
+// of the output directory, and an index of them. The objects without a Seller are in the report "no-seller".
+func (r *Reporter) GenerateSellerReports(report *ValidationReport) ([]SellerSummary, error) {
+	dir := filepath.Join(r.config.OutputDir, sellersDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sellers directory: %w", err)
+	}
+
+	groups := GroupBySeller(report.Results)
+	sellers := make([]string, 0, len(groups))
+	for seller := range groups {
+		sellers = append(sellers, seller)
+	}
+	sort.Strings(sellers)
+
+	index := sellerIndex{RunID: report.RunID, BaseURL: report.Config.BaseURL, GeneratedAt: report.GeneratedAt}
+	usedNames := make(map[string]bool)
+	for _, seller := range sellers {
+		name := sellerFileName(seller, usedNames)
+
+		sellerReport := &ValidationReport{
+			RunID:       report.RunID,
+			Seller:      seller,
+			Config:      report.Config,
+			Statistics:  r.calculateStatistics(groups[seller]),
+			Results:     groups[seller],
+			GeneratedAt: report.GeneratedAt,
+		}
+		sellerReport.Statistics.StartTime = report.Statistics.StartTime
+		sellerReport.Statistics.EndTime = report.Statistics.EndTime
+		sellerReport.Statistics.Duration = report.Statistics.Duration
+
+		summary := SellerSummary{Seller: seller, Statistics: sellerReport.Statistics, Files: make(map[string]string)}
+		for _, format := range r.sellerFormats() {
+			file := name + reportExtensions[format]
+			if err := r.generateReportFile(sellerReport, format, filepath.Join(dir, file)); err != nil {
+				return nil, fmt.Errorf("failed to generate %s report of seller %s: %w", format, seller, err)
+			}
+			summary.Files[format] = file
+		}
+		index.Sellers = append(index.Sellers, summary)
+	}
+
+	for _, format := range r.sellerFormats() {
+		if err := writeSellerIndexFile(r.sellerIndexPath(format), &index, format); err != nil {
+			return nil, fmt.Errorf("failed to generate %s index of sellers: %w", format, err)
+		}
+	}
+
+	return index.Sellers, nil
+}
+
+// sellerFileName returns the name of the report files of a seller, without extension, which is
+// different from the names already used
+func sellerFileName(seller string, used map[string]bool) string {
+	base := noSellerName
+	if seller != "" {
+		base = unsafeFileChars.ReplaceAllString(seller, "_")
+	}
+	name := base
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// writeSellerIndexFile writes the index of the reports of the sellers to a file in a format
+func writeSellerIndexFile(path string, index *sellerIndex, format string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create index file: %w", err)
+	}
+	defer file.Close()
+
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(index)
+	case FormatHTML:
+		err = sellerIndexTemplate.Execute(file, index)
+	default:
+		writeSellerIndexMarkdown(file, index, format)
+	}
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// writeSellerIndexMarkdown writes the index of the reports of the sellers in Markdown format
+func writeSellerIndexMarkdown(w io.Writer, index *sellerIndex, format string) {
+	fmt.Fprintf(w, "# TMForum Object Validation Reports by Seller\n\n")
+	fmt.Fprintf(w, "**Generated:** %s\n\n", index.GeneratedAt.Format("2006-01-02 15:04:05 UTC"))
+	fmt.Fprintf(w, "**Run:** `%s`\n\n", index.RunID)
+	fmt.Fprintf(w, "**Base URL:** `%s`\n\n", index.BaseURL)
+	fmt.Fprintf(w, "| Seller | Objects | Valid | Invalid | Errors | Warnings | Report |\n")
+	fmt.Fprintf(w, "|--------|---------|-------|---------|--------|----------|--------|\n")
+	for _, s := range index.Sellers {
+		seller := s.Seller
+		if seller == "" {
+			seller = "(no seller)"
+		}
+		file := s.Files[format]
+		fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %d | [%s](%s) |\n", seller, s.Statistics.TotalObjects, s.Statistics.ValidObjects,
+			s.Statistics.InvalidObjects, s.Statistics.TotalErrors, s.Statistics.TotalWarnings, file, file)
+	}
+}
+
+var sellerIndexTemplate = template.Must(template.New("index").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("2006-01-02 15:04:05 UTC") },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>TMForum Object Validation Reports by Seller</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>TMForum Object Validation Reports by Seller</h1>
+<p><strong>Generated:</strong> {{date .GeneratedAt}}<br>
+<strong>Run:</strong> <code>{{.RunID}}</code><br>
+<strong>Base URL:</strong> <code>{{.BaseURL}}</code></p>
+<table>
+<tr><th>Seller</th><th>Objects</th><th>Valid</th><th>Invalid</th><th>Errors</th><th>Warnings</th></tr>
+{{range .Sellers}}<tr><td><a href="{{index .Files "html"}}">{{if .Seller}}{{.Seller}}{{else}}(no seller){{end}}</a></td><td>{{.Statistics.TotalObjects}}</td><td>{{.Statistics.ValidObjects}}</td><td>{{.Statistics.InvalidObjects}}</td><td>{{.Statistics.TotalErrors}}</td><td>{{.Statistics.TotalWarnings}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
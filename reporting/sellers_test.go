@@ -0,0 +1,70 @@
+package reporting
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateSellerReports(t *testing.T) {
+	config := DefaultConfig()
+	config.OutputDir = t.TempDir()
+	config.ReportFormats = []string{FormatJSON, FormatJUnit, FormatHTML}
+	config.ReportBySeller = true
+
+	results := []ValidationResult{
+		{ObjectID: "po-1", ObjectType: "productOffering", Seller: "did:elsi:VATES-1", Valid: true, Timestamp: time.Now()},
+		{ObjectID: "po-2", ObjectType: "productOffering", Seller: "did:elsi:VATES-2", Valid: false, Timestamp: time.Now(),
+			Errors: []ValidationError{{Field: "href", Code: "MISSING_REQUIRED_FIELD"}}},
+		{ObjectID: "ps-1", ObjectType: "productSpecification", Seller: "did:elsi:VATES-1", Valid: true, Timestamp: time.Now()},
+		{ObjectID: "cat-1", ObjectType: "category", Valid: true, Timestamp: time.Now()},
+	}
+
+	reporter := NewReporter(config)
+	if _, err := reporter.GenerateReport(results); err != nil {
+		t.Fatalf("Failed to generate report: %v", err)
+	}
+	dir := filepath.Join(config.OutputDir, sellersDir)
+
+	// Each seller only gets its own results
+	var report ValidationReport
+	content, err := os.ReadFile(filepath.Join(dir, "did_elsi_VATES-1.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &report); err != nil || report.Seller != "did:elsi:VATES-1" || len(report.Results) != 2 || report.Statistics.TotalObjects != 2 {
+		t.Errorf("Unexpected seller report: %v %+v", err, report)
+	}
+
+	var index sellerIndex
+	content, err = os.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, &index); err != nil || len(index.Sellers) != 3 {
+		t.Fatalf("Unexpected index: %v %+v", err, index)
+	}
+	if first := index.Sellers[0]; first.Seller != "" || first.Files[FormatHTML] != "no-seller.html" {
+		t.Errorf("Unexpected objects without seller: %+v", first)
+	}
+	if s := index.Sellers[2]; s.Statistics.InvalidObjects != 1 || s.Files[FormatJSON] != "did_elsi_VATES-2.json" {
+		t.Errorf("Unexpected seller in index: %+v", s)
+	}
+
+	// Only the formats for the sellers are generated
+	if _, err := os.Stat(filepath.Join(dir, "did_elsi_VATES-1.junit.xml")); !os.IsNotExist(err) {
+		t.Errorf("Unexpected JUnit report for seller: %v", err)
+	}
+	html, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	if err != nil || !strings.Contains(string(html), `<a href="did_elsi_VATES-2.html">did:elsi:VATES-2</a>`) {
+		t.Errorf("Unexpected HTML index: %v\n%s", err, html)
+	}
+
+	used := map[string]bool{}
+	if a, b := sellerFileName("did:elsi:1", used), sellerFileName("did/elsi/1", used); a != "did_elsi_1" || b != "did_elsi_1_2" {
+		t.Errorf("Unexpected file names %q %q", a, b)
+	}
+}
@@ -2,6 +2,7 @@ package reporting
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -17,6 +18,53 @@ type TMFObject struct {
 	AdditionalFields map[string]any `json:"-"`
 }
 
+// NewTMFObjectFromMap creates a TMFObject from the generic representation of a TMForum object
+func NewTMFObjectFromMap(m map[string]any) TMFObject {
+	obj := TMFObject{AdditionalFields: make(map[string]any)}
+	for key, value := range m {
+		switch key {
+		case "id":
+			obj.ID, _ = value.(string)
+		case "href":
+			obj.Href, _ = value.(string)
+		case "lastUpdate":
+			obj.LastUpdate, _ = value.(string)
+		case "version":
+			obj.Version, _ = value.(string)
+		case "@type":
+			obj.Type, _ = value.(string)
+		case "relatedParty":
+			obj.RelatedParty, _ = json.Marshal(value)
+		default:
+			obj.AdditionalFields[key] = value
+		}
+	}
+	return obj
+}
+
+// Seller returns the id of the party with the Seller role in the relatedParty of the object, in the format of
+// TMForum v4 or v5, or an empty string if there is none
+func (obj TMFObject) Seller() string {
+	var relatedParties []struct {
+		ID               string              `json:"id"`
+		Role             string              `json:"role"`
+		PartyOrPartyRole PartyRefOrPartyRole `json:"partyOrPartyRole"`
+	}
+	if err := json.Unmarshal(obj.RelatedParty, &relatedParties); err != nil {
+		return ""
+	}
+	for _, rp := range relatedParties {
+		if !strings.EqualFold(rp.Role, "seller") {
+			continue
+		}
+		if rp.ID != "" {
+			return rp.ID
+		}
+		return rp.PartyOrPartyRole.ID
+	}
+	return ""
+}
+
 // RelatedParty represents a related party reference
 type RelatedParty struct {
 	Role             string              `json:"role"`
@@ -44,6 +92,7 @@ type PartyRefOrPartyRole struct {
 type ValidationResult struct {
 	ObjectID   string              `json:"object_id"`
 	ObjectType string              `json:"object_type"`
+	Seller     string              `json:"seller,omitempty"`
 	Valid      bool                `json:"valid"`
 	Errors     []ValidationError   `json:"errors,omitempty"`
 	Warnings   []ValidationWarning `json:"warnings,omitempty"`
@@ -90,6 +139,8 @@ type TypeStats struct {
 
 // ValidationReport represents the complete validation report
 type ValidationReport struct {
+	RunID       string             `json:"run_id"`
+	Seller      string             `json:"seller,omitempty"`
 	Config      *Config            `json:"config"`
 	Statistics  *Statistics        `json:"statistics"`
 	Results     []ValidationResult `json:"results"`
@@ -149,6 +200,17 @@ var RequiredFields = map[string][]string{
 	},
 }
 
+// RequiredFieldsForStatus defines the fields that objects of each type must have, with a value which is not empty,
+// when their lifecycleStatus has a given value. Sub-objects are separated by dots, and a field inside a list
+// must be present in every element of the list, which can not be empty either.
+var RequiredFieldsForStatus = map[string]map[string][]string{
+	"productOffering": {
+		"Launched": {
+			"productOfferingPrice.id", "productSpecification.id", "category.id", "validFor.startDateTime",
+		},
+	},
+}
+
 // RequiredRelatedPartyRoles defines the required related party roles for each object type
 var RequiredRelatedPartyRoles = map[string][]string{
 	"productOffering": {
@@ -11,12 +11,19 @@ import (
 // Validator validates TMF objects against requirements
 type Validator struct {
 	config *Config
+	rules  *Rules
 }
 
-// NewValidator creates a new validator
+// NewValidator creates a new validator with the default rules
 func NewValidator(config *Config) *Validator {
+	return NewValidatorWithRules(config, DefaultRules())
+}
+
+// NewValidatorWithRules creates a new validator with the given rules, for example loaded with LoadRulesFile
+func NewValidatorWithRules(config *Config, rules *Rules) *Validator {
 	return &Validator{
 		config: config,
+		rules:  rules,
 	}
 }
 
@@ -25,6 +32,7 @@ func (v *Validator) ValidateObject(obj TMFObject, objectType string) ValidationR
 	result := ValidationResult{
 		ObjectID:   obj.ID,
 		ObjectType: objectType,
+		Seller:     obj.Seller(),
 		Valid:      true,
 		Timestamp:  time.Now(),
 	}
@@ -34,6 +42,12 @@ func (v *Validator) ValidateObject(obj TMFObject, objectType string) ValidationR
 		v.validateRequiredFields(obj, objectType, &result)
 	}
 
+	// Validate the fields required by the lifecycleStatus of the object
+	if v.config.ValidateRequiredFields {
+		status, _ := obj.AdditionalFields["lifecycleStatus"].(string)
+		v.validateStatusFields(obj, objectType, status, &result)
+	}
+
 	// Validate related party requirements
 	if v.config.ValidateRelatedParty {
 		if v.config.Version == VersionV4 {
@@ -43,6 +57,9 @@ func (v *Validator) ValidateObject(obj TMFObject, objectType string) ValidationR
 		}
 	}
 
+	// Apply the additional rules of the marketplace
+	v.validateRules(obj, objectType, &result)
+
 	// Determine overall validity
 	result.Valid = len(result.Errors) == 0
 
@@ -52,12 +69,31 @@ func (v *Validator) ValidateObject(obj TMFObject, objectType string) ValidationR
 // validateRequiredFields checks if all required fields are present
 func (v *Validator) validateRequiredFields(obj TMFObject, objectType string, result *ValidationResult) {
 
-	// This checks the fields that are required for all objects
-	for _, field := range RequiredFieldsForAllObjects {
-		if !v.hasField(obj, field) {
+	// This checks the fields that are required for all objects, and then the ones for the type of object
+	fields := slices.Clone(v.rules.RequiredFieldsForAllObjects)
+	for _, field := range v.rules.RequiredFields[objectType] {
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	var objMap map[string]any
+	for _, field := range fields {
+		var missing []string
+		if !strings.Contains(field, ".") {
+			if !v.hasField(obj, field) {
+				missing = []string{field}
+			}
+		} else {
+			if objMap == nil {
+				objMap = obj.asMap()
+			}
+			missing = missingAt(objMap, field)
+		}
+		for _, m := range missing {
 			result.Errors = append(result.Errors, ValidationError{
-				Field:   field,
-				Message: fmt.Sprintf("Required field '%s' is missing", field),
+				Field:   m,
+				Message: fmt.Sprintf("Required field '%s' is missing", m),
 				Code:    "MISSING_REQUIRED_FIELD",
 			})
 		}
@@ -65,10 +101,122 @@ func (v *Validator) validateRequiredFields(obj TMFObject, objectType string, res
 
 }
 
+// validateRules applies the additional rules and scripts to the object
+func (v *Validator) validateRules(obj TMFObject, objectType string, result *ValidationResult) {
+	if len(v.rules.Rules) == 0 && len(v.rules.Scripts) == 0 {
+		return
+	}
+
+	objMap := obj.asMap()
+	var problems []problem
+	for i := range v.rules.Rules {
+		rule := &v.rules.Rules[i]
+		if rule.applies(objMap, objectType) {
+			problems = append(problems, rule.check(objMap)...)
+		}
+	}
+
+	for _, script := range v.rules.Scripts {
+		found, err := script.check(objMap, objectType)
+		if err != nil {
+			// A failing script does not make the objects invalid, but it is reported in all of them
+			problems = append(problems, problem{
+				code:     "SCRIPT_FAILED",
+				message:  fmt.Sprintf("Rule script %s failed: %v", script.Name, err),
+				severity: SeverityWarning,
+			})
+			continue
+		}
+		problems = append(problems, found...)
+	}
+
+	for _, p := range problems {
+		if p.severity == SeverityWarning {
+			result.Warnings = append(result.Warnings, ValidationWarning{Field: p.field, Message: p.message, Code: p.code})
+		} else {
+			result.Errors = append(result.Errors, ValidationError{Field: p.field, Message: p.message, Code: p.code})
+		}
+	}
+}
+
+// ValidateForStatus checks that an object has the fields required to have the given lifecycleStatus,
+// for example before changing it
+func (v *Validator) ValidateForStatus(obj TMFObject, objectType string, status string) ValidationResult {
+	result := ValidationResult{
+		ObjectID:   obj.ID,
+		ObjectType: objectType,
+		Valid:      true,
+		Timestamp:  time.Now(),
+	}
+
+	v.validateStatusFields(obj, objectType, status, &result)
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// FieldsForStatus returns the fields which the rules of the validator require for objects of the type
+// with the given lifecycleStatus
+func (v *Validator) FieldsForStatus(objectType string, status string) []string {
+	return v.rules.RequiredFieldsForStatus[objectType][status]
+}
+
+// ChecksStatusFields reports whether ValidateObject checks the fields required by the lifecycleStatus of the object
+func (v *Validator) ChecksStatusFields() bool {
+	return v.config != nil && v.config.ValidateRequiredFields
+}
+
+// validateStatusFields checks the fields required for the lifecycleStatus, reporting every one missing
+func (v *Validator) validateStatusFields(obj TMFObject, objectType string, status string, result *ValidationResult) {
+	for _, field := range v.rules.RequiredFieldsForStatus[objectType][status] {
+		path := strings.Split(field, ".")
+		for _, missing := range missingFields(obj.AdditionalFields[path[0]], path[1:], path[0]) {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   missing,
+				Message: fmt.Sprintf("Field '%s' is required when lifecycleStatus is '%s'", missing, status),
+				Code:    "MISSING_FIELD_FOR_STATUS",
+			})
+		}
+	}
+}
+
+// missingFields returns the fields in the path which are missing or empty inside value, named from prefix
+func missingFields(value any, path []string, prefix string) []string {
+	switch v := value.(type) {
+	case nil:
+		return []string{prefix}
+	case string:
+		if v == "" || len(path) > 0 {
+			return []string{prefix}
+		}
+	case []any:
+		if len(v) == 0 {
+			return []string{prefix}
+		}
+		var missing []string
+		for i, elem := range v {
+			missing = append(missing, missingFields(elem, path, fmt.Sprintf("%s[%d]", prefix, i))...)
+		}
+		return missing
+	case map[string]any:
+		if len(v) == 0 {
+			return []string{prefix}
+		}
+		if len(path) > 0 {
+			return missingFields(v[path[0]], path[1:], prefix+"."+path[0])
+		}
+	default:
+		if len(path) > 0 {
+			return []string{prefix}
+		}
+	}
+	return nil
+}
+
 // validateRelatedPartyV5 checks if required related party roles are present
 func (v *Validator) validateRelatedPartyV5(obj TMFObject, objectType string, result *ValidationResult) {
 	// We just return if the object does not require any Related Party
-	if slices.Contains(DoNotRequireRelatedParties, objectType) {
+	if slices.Contains(v.rules.DoNotRequireRelatedParties, objectType) {
 		return
 	}
 
@@ -85,7 +233,7 @@ func (v *Validator) validateRelatedPartyV5(obj TMFObject, objectType string, res
 		})
 	}
 
-	requiredRoles := RequiredRelatedPartyRoles[objectType]
+	requiredRoles := v.rules.RequiredRelatedPartyRoles[objectType]
 	if len(requiredRoles) == 0 {
 		return
 	}
@@ -185,7 +333,7 @@ func (v *Validator) validateRelatedPartyV4(obj TMFObject, objectType string, res
 	}
 
 	// We just return if the object does not require any Related Party
-	if slices.Contains(DoNotRequireRelatedParties, objectType) {
+	if slices.Contains(v.rules.DoNotRequireRelatedParties, objectType) {
 		return
 	}
 
@@ -250,7 +398,7 @@ func (v *Validator) validateRelatedPartyV4(obj TMFObject, objectType string, res
 
 	// Set the required roles depending on the type of object
 	requiredRoles := []string{"seller", "selleroperator", "buyer", "buyeroperator"}
-	if slices.Contains(DoNotRequireBuyerInfo, objectType) {
+	if slices.Contains(v.rules.DoNotRequireBuyerInfo, objectType) {
 		requiredRoles = []string{"seller", "selleroperator"}
 	}
 
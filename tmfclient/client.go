@@ -0,0 +1,243 @@
+// Package tmfclient is a typed client for the TMForum APIs implemented by isbetmf.
+//
+// The models (models_gen.go) and the typed operations of each resource (client_gen.go) are generated
+// from the OpenAPI documents in oapiv5 by cmd/isbeoapi. This file contains the generic operations
+// used by the generated code, which can also be used directly for resources not yet generated.
+package tmfclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client is a client of a server implementing the TMForum v5 APIs.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken sets the access token sent in the Authorization header of every request.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// New creates a client of the server at baseURL, like "https://tmf.example.com".
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ListOptions are the TMF630 query options of list operations.
+type ListOptions struct {
+	// Fields selects the attributes returned for each object
+	Fields []string
+
+	// Expand selects the references replaced by the objects referenced, like "productSpecification"
+	Expand []string
+
+	// Offset and Limit select a page of the results. Zero values are not sent.
+	Offset int
+	Limit  int
+
+	// Sort lists the attributes used to sort the results. A '-' prefix sorts in descending order.
+	Sort []string
+
+	// Filter are the attribute filters, like "lifecycleStatus=Launched" or, with operators,
+	// "lastUpdate.gt=2025-01-01T00:00:00Z"
+	Filter url.Values
+}
+
+// Values returns the query parameters of the options.
+func (o *ListOptions) Values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	for k, v := range o.Filter {
+		q[k] = append([]string(nil), v...)
+	}
+	if len(o.Fields) > 0 {
+		q.Set("fields", strings.Join(o.Fields, ","))
+	}
+	if len(o.Expand) > 0 {
+		q.Set("expand", strings.Join(o.Expand, ","))
+	}
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if len(o.Sort) > 0 {
+		q.Set("sort", strings.Join(o.Sort, ","))
+	}
+	return q
+}
+
+// GetOptions are the TMF630 query options of retrieve operations.
+type GetOptions struct {
+	// Fields selects the attributes returned
+	Fields []string
+
+	// Expand selects the references replaced by the objects referenced
+	Expand []string
+}
+
+func (o *GetOptions) values() url.Values {
+	q := url.Values{}
+	if o != nil && len(o.Fields) > 0 {
+		q.Set("fields", strings.Join(o.Fields, ","))
+	}
+	if o != nil && len(o.Expand) > 0 {
+		q.Set("expand", strings.Join(o.Expand, ","))
+	}
+	return q
+}
+
+// Error is returned when the server replies with an error. It contains the TMForum Error object
+// of the response, if the server sent one.
+type Error struct {
+	StatusCode int `json:"-"`
+
+	Code           string        `json:"code"`
+	Reason         string        `json:"reason"`
+	Message        string        `json:"message,omitempty"`
+	Status         string        `json:"status,omitempty"`
+	ReferenceError string        `json:"referenceError,omitempty"`
+	Details        []ErrorDetail `json:"details,omitempty"`
+}
+
+// ErrorDetail is an individual problem of a request, like an invalid property of the body.
+type ErrorDetail struct {
+	Pointer string `json:"pointer,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("server returned status %d: %s", e.StatusCode, e.Message)
+	}
+	if e.Reason != "" {
+		return fmt.Sprintf("server returned status %d: %s", e.StatusCode, e.Reason)
+	}
+	return fmt.Sprintf("server returned status %d", e.StatusCode)
+}
+
+// resourcePath returns the path of the collection of a resource, or of an object if id is not empty
+func resourcePath(apiFamily, resource, id string) string {
+	p := "/tmf-api/" + apiFamily + "/v5/" + resource
+	if id != "" {
+		p += "/" + url.PathEscape(id)
+	}
+	return p
+}
+
+// Create creates an object of a resource, decoding the created object into out (if not nil).
+func (c *Client) Create(ctx context.Context, apiFamily, resource string, in, out any) error {
+	return c.do(ctx, http.MethodPost, resourcePath(apiFamily, resource, ""), nil, "application/json", in, out)
+}
+
+// Get retrieves an object of a resource into out.
+func (c *Client) Get(ctx context.Context, apiFamily, resource, id string, opts *GetOptions, out any) error {
+	return c.do(ctx, http.MethodGet, resourcePath(apiFamily, resource, id), opts.values(), "", nil, out)
+}
+
+// List retrieves the objects of a resource matching the options into out, which must be a pointer to a slice.
+func (c *Client) List(ctx context.Context, apiFamily, resource string, opts *ListOptions, out any) error {
+	return c.do(ctx, http.MethodGet, resourcePath(apiFamily, resource, ""), opts.Values(), "", nil, out)
+}
+
+// Patch updates an object of a resource with a JSON Merge Patch (RFC 7396), decoding the updated object
+// into out (if not nil). Only the attributes present in the patch are modified, and null values remove them.
+func (c *Client) Patch(ctx context.Context, apiFamily, resource, id string, patch, out any) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(apiFamily, resource, id), nil, "application/merge-patch+json", patch, out)
+}
+
+// Delete deletes an object of a resource.
+func (c *Client) Delete(ctx context.Context, apiFamily, resource, id string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(apiFamily, resource, id), nil, "", nil, nil)
+}
+
+// do sends a request and decodes the response
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, contentType string, in, out any) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &Error{}
+		if len(respBody) > 0 && json.Unmarshal(respBody, apiErr) != nil {
+			apiErr.Message = string(respBody)
+		}
+		apiErr.StatusCode = resp.StatusCode
+		return apiErr
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
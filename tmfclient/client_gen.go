@@ -0,0 +1,1026 @@
+// Code generated by isbeoapi from the OpenAPI documents in oapiv5. DO NOT EDIT.
+
+package tmfclient
+
+import "context"
+
+// Agreement operations (agreementManagement/agreement)
+
+// CreateAgreement creates a new Agreement object and returns the object created by the server.
+func (c *Client) CreateAgreement(ctx context.Context, in *Agreement) (*Agreement, error) {
+	out := &Agreement{}
+	if err := c.Create(ctx, "agreementManagement", "agreement", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAgreement retrieves the Agreement with the given id.
+func (c *Client) GetAgreement(ctx context.Context, id string, opts *GetOptions) (*Agreement, error) {
+	out := &Agreement{}
+	if err := c.Get(ctx, "agreementManagement", "agreement", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListAgreements retrieves the Agreement objects matching the options.
+func (c *Client) ListAgreements(ctx context.Context, opts *ListOptions) ([]Agreement, error) {
+	var out []Agreement
+	if err := c.List(ctx, "agreementManagement", "agreement", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchAgreement updates the attributes present in patch of the Agreement with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchAgreement(ctx context.Context, id string, patch *Agreement) (*Agreement, error) {
+	out := &Agreement{}
+	if err := c.Patch(ctx, "agreementManagement", "agreement", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteAgreement deletes the Agreement with the given id.
+func (c *Client) DeleteAgreement(ctx context.Context, id string) error {
+	return c.Delete(ctx, "agreementManagement", "agreement", id)
+}
+
+// AgreementSpecification operations (agreementManagement/agreementSpecification)
+
+// CreateAgreementSpecification creates a new AgreementSpecification object and returns the object created by the server.
+func (c *Client) CreateAgreementSpecification(ctx context.Context, in *AgreementSpecification) (*AgreementSpecification, error) {
+	out := &AgreementSpecification{}
+	if err := c.Create(ctx, "agreementManagement", "agreementSpecification", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAgreementSpecification retrieves the AgreementSpecification with the given id.
+func (c *Client) GetAgreementSpecification(ctx context.Context, id string, opts *GetOptions) (*AgreementSpecification, error) {
+	out := &AgreementSpecification{}
+	if err := c.Get(ctx, "agreementManagement", "agreementSpecification", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListAgreementSpecifications retrieves the AgreementSpecification objects matching the options.
+func (c *Client) ListAgreementSpecifications(ctx context.Context, opts *ListOptions) ([]AgreementSpecification, error) {
+	var out []AgreementSpecification
+	if err := c.List(ctx, "agreementManagement", "agreementSpecification", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchAgreementSpecification updates the attributes present in patch of the AgreementSpecification with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchAgreementSpecification(ctx context.Context, id string, patch *AgreementSpecification) (*AgreementSpecification, error) {
+	out := &AgreementSpecification{}
+	if err := c.Patch(ctx, "agreementManagement", "agreementSpecification", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteAgreementSpecification deletes the AgreementSpecification with the given id.
+func (c *Client) DeleteAgreementSpecification(ctx context.Context, id string) error {
+	return c.Delete(ctx, "agreementManagement", "agreementSpecification", id)
+}
+
+// Individual operations (partyManagement/individual)
+
+// CreateIndividual creates a new Individual object and returns the object created by the server.
+func (c *Client) CreateIndividual(ctx context.Context, in *Individual) (*Individual, error) {
+	out := &Individual{}
+	if err := c.Create(ctx, "partyManagement", "individual", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetIndividual retrieves the Individual with the given id.
+func (c *Client) GetIndividual(ctx context.Context, id string, opts *GetOptions) (*Individual, error) {
+	out := &Individual{}
+	if err := c.Get(ctx, "partyManagement", "individual", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListIndividuals retrieves the Individual objects matching the options.
+func (c *Client) ListIndividuals(ctx context.Context, opts *ListOptions) ([]Individual, error) {
+	var out []Individual
+	if err := c.List(ctx, "partyManagement", "individual", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchIndividual updates the attributes present in patch of the Individual with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchIndividual(ctx context.Context, id string, patch *Individual) (*Individual, error) {
+	out := &Individual{}
+	if err := c.Patch(ctx, "partyManagement", "individual", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteIndividual deletes the Individual with the given id.
+func (c *Client) DeleteIndividual(ctx context.Context, id string) error {
+	return c.Delete(ctx, "partyManagement", "individual", id)
+}
+
+// Organization operations (partyManagement/organization)
+
+// CreateOrganization creates a new Organization object and returns the object created by the server.
+func (c *Client) CreateOrganization(ctx context.Context, in *Organization) (*Organization, error) {
+	out := &Organization{}
+	if err := c.Create(ctx, "partyManagement", "organization", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetOrganization retrieves the Organization with the given id.
+func (c *Client) GetOrganization(ctx context.Context, id string, opts *GetOptions) (*Organization, error) {
+	out := &Organization{}
+	if err := c.Get(ctx, "partyManagement", "organization", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListOrganizations retrieves the Organization objects matching the options.
+func (c *Client) ListOrganizations(ctx context.Context, opts *ListOptions) ([]Organization, error) {
+	var out []Organization
+	if err := c.List(ctx, "partyManagement", "organization", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchOrganization updates the attributes present in patch of the Organization with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchOrganization(ctx context.Context, id string, patch *Organization) (*Organization, error) {
+	out := &Organization{}
+	if err := c.Patch(ctx, "partyManagement", "organization", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteOrganization deletes the Organization with the given id.
+func (c *Client) DeleteOrganization(ctx context.Context, id string) error {
+	return c.Delete(ctx, "partyManagement", "organization", id)
+}
+
+// Category operations (productCatalogManagement/category)
+
+// CreateCategory creates a new Category object and returns the object created by the server.
+func (c *Client) CreateCategory(ctx context.Context, in *Category) (*Category, error) {
+	out := &Category{}
+	if err := c.Create(ctx, "productCatalogManagement", "category", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCategory retrieves the Category with the given id.
+func (c *Client) GetCategory(ctx context.Context, id string, opts *GetOptions) (*Category, error) {
+	out := &Category{}
+	if err := c.Get(ctx, "productCatalogManagement", "category", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListCategories retrieves the Category objects matching the options.
+func (c *Client) ListCategories(ctx context.Context, opts *ListOptions) ([]Category, error) {
+	var out []Category
+	if err := c.List(ctx, "productCatalogManagement", "category", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchCategory updates the attributes present in patch of the Category with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchCategory(ctx context.Context, id string, patch *Category) (*Category, error) {
+	out := &Category{}
+	if err := c.Patch(ctx, "productCatalogManagement", "category", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteCategory deletes the Category with the given id.
+func (c *Client) DeleteCategory(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "category", id)
+}
+
+// ExportJob operations (productCatalogManagement/exportJob)
+
+// CreateExportJob creates a new ExportJob object and returns the object created by the server.
+func (c *Client) CreateExportJob(ctx context.Context, in *ExportJob) (*ExportJob, error) {
+	out := &ExportJob{}
+	if err := c.Create(ctx, "productCatalogManagement", "exportJob", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetExportJob retrieves the ExportJob with the given id.
+func (c *Client) GetExportJob(ctx context.Context, id string, opts *GetOptions) (*ExportJob, error) {
+	out := &ExportJob{}
+	if err := c.Get(ctx, "productCatalogManagement", "exportJob", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListExportJobs retrieves the ExportJob objects matching the options.
+func (c *Client) ListExportJobs(ctx context.Context, opts *ListOptions) ([]ExportJob, error) {
+	var out []ExportJob
+	if err := c.List(ctx, "productCatalogManagement", "exportJob", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteExportJob deletes the ExportJob with the given id.
+func (c *Client) DeleteExportJob(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "exportJob", id)
+}
+
+// ImportJob operations (productCatalogManagement/importJob)
+
+// CreateImportJob creates a new ImportJob object and returns the object created by the server.
+func (c *Client) CreateImportJob(ctx context.Context, in *ImportJob) (*ImportJob, error) {
+	out := &ImportJob{}
+	if err := c.Create(ctx, "productCatalogManagement", "importJob", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetImportJob retrieves the ImportJob with the given id.
+func (c *Client) GetImportJob(ctx context.Context, id string, opts *GetOptions) (*ImportJob, error) {
+	out := &ImportJob{}
+	if err := c.Get(ctx, "productCatalogManagement", "importJob", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListImportJobs retrieves the ImportJob objects matching the options.
+func (c *Client) ListImportJobs(ctx context.Context, opts *ListOptions) ([]ImportJob, error) {
+	var out []ImportJob
+	if err := c.List(ctx, "productCatalogManagement", "importJob", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteImportJob deletes the ImportJob with the given id.
+func (c *Client) DeleteImportJob(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "importJob", id)
+}
+
+// ProductCatalog operations (productCatalogManagement/productCatalog)
+
+// CreateProductCatalog creates a new ProductCatalog object and returns the object created by the server.
+func (c *Client) CreateProductCatalog(ctx context.Context, in *ProductCatalog) (*ProductCatalog, error) {
+	out := &ProductCatalog{}
+	if err := c.Create(ctx, "productCatalogManagement", "productCatalog", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProductCatalog retrieves the ProductCatalog with the given id.
+func (c *Client) GetProductCatalog(ctx context.Context, id string, opts *GetOptions) (*ProductCatalog, error) {
+	out := &ProductCatalog{}
+	if err := c.Get(ctx, "productCatalogManagement", "productCatalog", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProductCatalogs retrieves the ProductCatalog objects matching the options.
+func (c *Client) ListProductCatalogs(ctx context.Context, opts *ListOptions) ([]ProductCatalog, error) {
+	var out []ProductCatalog
+	if err := c.List(ctx, "productCatalogManagement", "productCatalog", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProductCatalog updates the attributes present in patch of the ProductCatalog with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProductCatalog(ctx context.Context, id string, patch *ProductCatalog) (*ProductCatalog, error) {
+	out := &ProductCatalog{}
+	if err := c.Patch(ctx, "productCatalogManagement", "productCatalog", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProductCatalog deletes the ProductCatalog with the given id.
+func (c *Client) DeleteProductCatalog(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "productCatalog", id)
+}
+
+// ProductOffering operations (productCatalogManagement/productOffering)
+
+// CreateProductOffering creates a new ProductOffering object and returns the object created by the server.
+func (c *Client) CreateProductOffering(ctx context.Context, in *ProductOffering) (*ProductOffering, error) {
+	out := &ProductOffering{}
+	if err := c.Create(ctx, "productCatalogManagement", "productOffering", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProductOffering retrieves the ProductOffering with the given id.
+func (c *Client) GetProductOffering(ctx context.Context, id string, opts *GetOptions) (*ProductOffering, error) {
+	out := &ProductOffering{}
+	if err := c.Get(ctx, "productCatalogManagement", "productOffering", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProductOfferings retrieves the ProductOffering objects matching the options.
+func (c *Client) ListProductOfferings(ctx context.Context, opts *ListOptions) ([]ProductOffering, error) {
+	var out []ProductOffering
+	if err := c.List(ctx, "productCatalogManagement", "productOffering", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProductOffering updates the attributes present in patch of the ProductOffering with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProductOffering(ctx context.Context, id string, patch *ProductOffering) (*ProductOffering, error) {
+	out := &ProductOffering{}
+	if err := c.Patch(ctx, "productCatalogManagement", "productOffering", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProductOffering deletes the ProductOffering with the given id.
+func (c *Client) DeleteProductOffering(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "productOffering", id)
+}
+
+// ProductOfferingPrice operations (productCatalogManagement/productOfferingPrice)
+
+// CreateProductOfferingPrice creates a new ProductOfferingPrice object and returns the object created by the server.
+func (c *Client) CreateProductOfferingPrice(ctx context.Context, in *ProductOfferingPrice) (*ProductOfferingPrice, error) {
+	out := &ProductOfferingPrice{}
+	if err := c.Create(ctx, "productCatalogManagement", "productOfferingPrice", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProductOfferingPrice retrieves the ProductOfferingPrice with the given id.
+func (c *Client) GetProductOfferingPrice(ctx context.Context, id string, opts *GetOptions) (*ProductOfferingPrice, error) {
+	out := &ProductOfferingPrice{}
+	if err := c.Get(ctx, "productCatalogManagement", "productOfferingPrice", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProductOfferingPrices retrieves the ProductOfferingPrice objects matching the options.
+func (c *Client) ListProductOfferingPrices(ctx context.Context, opts *ListOptions) ([]ProductOfferingPrice, error) {
+	var out []ProductOfferingPrice
+	if err := c.List(ctx, "productCatalogManagement", "productOfferingPrice", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProductOfferingPrice updates the attributes present in patch of the ProductOfferingPrice with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProductOfferingPrice(ctx context.Context, id string, patch *ProductOfferingPrice) (*ProductOfferingPrice, error) {
+	out := &ProductOfferingPrice{}
+	if err := c.Patch(ctx, "productCatalogManagement", "productOfferingPrice", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProductOfferingPrice deletes the ProductOfferingPrice with the given id.
+func (c *Client) DeleteProductOfferingPrice(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "productOfferingPrice", id)
+}
+
+// ProductSpecification operations (productCatalogManagement/productSpecification)
+
+// CreateProductSpecification creates a new ProductSpecification object and returns the object created by the server.
+func (c *Client) CreateProductSpecification(ctx context.Context, in *ProductSpecification) (*ProductSpecification, error) {
+	out := &ProductSpecification{}
+	if err := c.Create(ctx, "productCatalogManagement", "productSpecification", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProductSpecification retrieves the ProductSpecification with the given id.
+func (c *Client) GetProductSpecification(ctx context.Context, id string, opts *GetOptions) (*ProductSpecification, error) {
+	out := &ProductSpecification{}
+	if err := c.Get(ctx, "productCatalogManagement", "productSpecification", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProductSpecifications retrieves the ProductSpecification objects matching the options.
+func (c *Client) ListProductSpecifications(ctx context.Context, opts *ListOptions) ([]ProductSpecification, error) {
+	var out []ProductSpecification
+	if err := c.List(ctx, "productCatalogManagement", "productSpecification", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProductSpecification updates the attributes present in patch of the ProductSpecification with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProductSpecification(ctx context.Context, id string, patch *ProductSpecification) (*ProductSpecification, error) {
+	out := &ProductSpecification{}
+	if err := c.Patch(ctx, "productCatalogManagement", "productSpecification", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProductSpecification deletes the ProductSpecification with the given id.
+func (c *Client) DeleteProductSpecification(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productCatalogManagement", "productSpecification", id)
+}
+
+// Product operations (productInventory/product)
+
+// CreateProduct creates a new Product object and returns the object created by the server.
+func (c *Client) CreateProduct(ctx context.Context, in *Product) (*Product, error) {
+	out := &Product{}
+	if err := c.Create(ctx, "productInventory", "product", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProduct retrieves the Product with the given id.
+func (c *Client) GetProduct(ctx context.Context, id string, opts *GetOptions) (*Product, error) {
+	out := &Product{}
+	if err := c.Get(ctx, "productInventory", "product", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProducts retrieves the Product objects matching the options.
+func (c *Client) ListProducts(ctx context.Context, opts *ListOptions) ([]Product, error) {
+	var out []Product
+	if err := c.List(ctx, "productInventory", "product", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProduct updates the attributes present in patch of the Product with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProduct(ctx context.Context, id string, patch *Product) (*Product, error) {
+	out := &Product{}
+	if err := c.Patch(ctx, "productInventory", "product", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProduct deletes the Product with the given id.
+func (c *Client) DeleteProduct(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productInventory", "product", id)
+}
+
+// CancelProductOrder operations (productOrderingManagement/cancelProductOrder)
+
+// CreateCancelProductOrder creates a new CancelProductOrder object and returns the object created by the server.
+func (c *Client) CreateCancelProductOrder(ctx context.Context, in *CancelProductOrder) (*CancelProductOrder, error) {
+	out := &CancelProductOrder{}
+	if err := c.Create(ctx, "productOrderingManagement", "cancelProductOrder", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCancelProductOrder retrieves the CancelProductOrder with the given id.
+func (c *Client) GetCancelProductOrder(ctx context.Context, id string, opts *GetOptions) (*CancelProductOrder, error) {
+	out := &CancelProductOrder{}
+	if err := c.Get(ctx, "productOrderingManagement", "cancelProductOrder", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListCancelProductOrders retrieves the CancelProductOrder objects matching the options.
+func (c *Client) ListCancelProductOrders(ctx context.Context, opts *ListOptions) ([]CancelProductOrder, error) {
+	var out []CancelProductOrder
+	if err := c.List(ctx, "productOrderingManagement", "cancelProductOrder", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductOrder operations (productOrderingManagement/productOrder)
+
+// CreateProductOrder creates a new ProductOrder object and returns the object created by the server.
+func (c *Client) CreateProductOrder(ctx context.Context, in *ProductOrder) (*ProductOrder, error) {
+	out := &ProductOrder{}
+	if err := c.Create(ctx, "productOrderingManagement", "productOrder", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetProductOrder retrieves the ProductOrder with the given id.
+func (c *Client) GetProductOrder(ctx context.Context, id string, opts *GetOptions) (*ProductOrder, error) {
+	out := &ProductOrder{}
+	if err := c.Get(ctx, "productOrderingManagement", "productOrder", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListProductOrders retrieves the ProductOrder objects matching the options.
+func (c *Client) ListProductOrders(ctx context.Context, opts *ListOptions) ([]ProductOrder, error) {
+	var out []ProductOrder
+	if err := c.List(ctx, "productOrderingManagement", "productOrder", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchProductOrder updates the attributes present in patch of the ProductOrder with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchProductOrder(ctx context.Context, id string, patch *ProductOrder) (*ProductOrder, error) {
+	out := &ProductOrder{}
+	if err := c.Patch(ctx, "productOrderingManagement", "productOrder", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteProductOrder deletes the ProductOrder with the given id.
+func (c *Client) DeleteProductOrder(ctx context.Context, id string) error {
+	return c.Delete(ctx, "productOrderingManagement", "productOrder", id)
+}
+
+// ResourceCandidate operations (resourceCatalog/resourceCandidate)
+
+// CreateResourceCandidate creates a new ResourceCandidate object and returns the object created by the server.
+func (c *Client) CreateResourceCandidate(ctx context.Context, in *ResourceCandidate) (*ResourceCandidate, error) {
+	out := &ResourceCandidate{}
+	if err := c.Create(ctx, "resourceCatalog", "resourceCandidate", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetResourceCandidate retrieves the ResourceCandidate with the given id.
+func (c *Client) GetResourceCandidate(ctx context.Context, id string, opts *GetOptions) (*ResourceCandidate, error) {
+	out := &ResourceCandidate{}
+	if err := c.Get(ctx, "resourceCatalog", "resourceCandidate", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListResourceCandidates retrieves the ResourceCandidate objects matching the options.
+func (c *Client) ListResourceCandidates(ctx context.Context, opts *ListOptions) ([]ResourceCandidate, error) {
+	var out []ResourceCandidate
+	if err := c.List(ctx, "resourceCatalog", "resourceCandidate", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchResourceCandidate updates the attributes present in patch of the ResourceCandidate with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchResourceCandidate(ctx context.Context, id string, patch *ResourceCandidate) (*ResourceCandidate, error) {
+	out := &ResourceCandidate{}
+	if err := c.Patch(ctx, "resourceCatalog", "resourceCandidate", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteResourceCandidate deletes the ResourceCandidate with the given id.
+func (c *Client) DeleteResourceCandidate(ctx context.Context, id string) error {
+	return c.Delete(ctx, "resourceCatalog", "resourceCandidate", id)
+}
+
+// ResourceCatalog operations (resourceCatalog/resourceCatalog)
+
+// CreateResourceCatalog creates a new ResourceCatalog object and returns the object created by the server.
+func (c *Client) CreateResourceCatalog(ctx context.Context, in *ResourceCatalog) (*ResourceCatalog, error) {
+	out := &ResourceCatalog{}
+	if err := c.Create(ctx, "resourceCatalog", "resourceCatalog", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetResourceCatalog retrieves the ResourceCatalog with the given id.
+func (c *Client) GetResourceCatalog(ctx context.Context, id string, opts *GetOptions) (*ResourceCatalog, error) {
+	out := &ResourceCatalog{}
+	if err := c.Get(ctx, "resourceCatalog", "resourceCatalog", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListResourceCatalogs retrieves the ResourceCatalog objects matching the options.
+func (c *Client) ListResourceCatalogs(ctx context.Context, opts *ListOptions) ([]ResourceCatalog, error) {
+	var out []ResourceCatalog
+	if err := c.List(ctx, "resourceCatalog", "resourceCatalog", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchResourceCatalog updates the attributes present in patch of the ResourceCatalog with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchResourceCatalog(ctx context.Context, id string, patch *ResourceCatalog) (*ResourceCatalog, error) {
+	out := &ResourceCatalog{}
+	if err := c.Patch(ctx, "resourceCatalog", "resourceCatalog", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteResourceCatalog deletes the ResourceCatalog with the given id.
+func (c *Client) DeleteResourceCatalog(ctx context.Context, id string) error {
+	return c.Delete(ctx, "resourceCatalog", "resourceCatalog", id)
+}
+
+// ResourceCategory operations (resourceCatalog/resourceCategory)
+
+// CreateResourceCategory creates a new ResourceCategory object and returns the object created by the server.
+func (c *Client) CreateResourceCategory(ctx context.Context, in *ResourceCategory) (*ResourceCategory, error) {
+	out := &ResourceCategory{}
+	if err := c.Create(ctx, "resourceCatalog", "resourceCategory", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetResourceCategory retrieves the ResourceCategory with the given id.
+func (c *Client) GetResourceCategory(ctx context.Context, id string, opts *GetOptions) (*ResourceCategory, error) {
+	out := &ResourceCategory{}
+	if err := c.Get(ctx, "resourceCatalog", "resourceCategory", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListResourceCategories retrieves the ResourceCategory objects matching the options.
+func (c *Client) ListResourceCategories(ctx context.Context, opts *ListOptions) ([]ResourceCategory, error) {
+	var out []ResourceCategory
+	if err := c.List(ctx, "resourceCatalog", "resourceCategory", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchResourceCategory updates the attributes present in patch of the ResourceCategory with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchResourceCategory(ctx context.Context, id string, patch *ResourceCategory) (*ResourceCategory, error) {
+	out := &ResourceCategory{}
+	if err := c.Patch(ctx, "resourceCatalog", "resourceCategory", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteResourceCategory deletes the ResourceCategory with the given id.
+func (c *Client) DeleteResourceCategory(ctx context.Context, id string) error {
+	return c.Delete(ctx, "resourceCatalog", "resourceCategory", id)
+}
+
+// ResourceSpecification operations (resourceCatalog/resourceSpecification)
+
+// CreateResourceSpecification creates a new ResourceSpecification object and returns the object created by the server.
+func (c *Client) CreateResourceSpecification(ctx context.Context, in *ResourceSpecification) (*ResourceSpecification, error) {
+	out := &ResourceSpecification{}
+	if err := c.Create(ctx, "resourceCatalog", "resourceSpecification", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetResourceSpecification retrieves the ResourceSpecification with the given id.
+func (c *Client) GetResourceSpecification(ctx context.Context, id string, opts *GetOptions) (*ResourceSpecification, error) {
+	out := &ResourceSpecification{}
+	if err := c.Get(ctx, "resourceCatalog", "resourceSpecification", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListResourceSpecifications retrieves the ResourceSpecification objects matching the options.
+func (c *Client) ListResourceSpecifications(ctx context.Context, opts *ListOptions) ([]ResourceSpecification, error) {
+	var out []ResourceSpecification
+	if err := c.List(ctx, "resourceCatalog", "resourceSpecification", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchResourceSpecification updates the attributes present in patch of the ResourceSpecification with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchResourceSpecification(ctx context.Context, id string, patch *ResourceSpecification) (*ResourceSpecification, error) {
+	out := &ResourceSpecification{}
+	if err := c.Patch(ctx, "resourceCatalog", "resourceSpecification", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteResourceSpecification deletes the ResourceSpecification with the given id.
+func (c *Client) DeleteResourceSpecification(ctx context.Context, id string) error {
+	return c.Delete(ctx, "resourceCatalog", "resourceSpecification", id)
+}
+
+// ServiceCandidate operations (serviceCatalogManagement/serviceCandidate)
+
+// CreateServiceCandidate creates a new ServiceCandidate object and returns the object created by the server.
+func (c *Client) CreateServiceCandidate(ctx context.Context, in *ServiceCandidate) (*ServiceCandidate, error) {
+	out := &ServiceCandidate{}
+	if err := c.Create(ctx, "serviceCatalogManagement", "serviceCandidate", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceCandidate retrieves the ServiceCandidate with the given id.
+func (c *Client) GetServiceCandidate(ctx context.Context, id string, opts *GetOptions) (*ServiceCandidate, error) {
+	out := &ServiceCandidate{}
+	if err := c.Get(ctx, "serviceCatalogManagement", "serviceCandidate", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServiceCandidates retrieves the ServiceCandidate objects matching the options.
+func (c *Client) ListServiceCandidates(ctx context.Context, opts *ListOptions) ([]ServiceCandidate, error) {
+	var out []ServiceCandidate
+	if err := c.List(ctx, "serviceCatalogManagement", "serviceCandidate", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchServiceCandidate updates the attributes present in patch of the ServiceCandidate with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchServiceCandidate(ctx context.Context, id string, patch *ServiceCandidate) (*ServiceCandidate, error) {
+	out := &ServiceCandidate{}
+	if err := c.Patch(ctx, "serviceCatalogManagement", "serviceCandidate", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteServiceCandidate deletes the ServiceCandidate with the given id.
+func (c *Client) DeleteServiceCandidate(ctx context.Context, id string) error {
+	return c.Delete(ctx, "serviceCatalogManagement", "serviceCandidate", id)
+}
+
+// ServiceCatalog operations (serviceCatalogManagement/serviceCatalog)
+
+// CreateServiceCatalog creates a new ServiceCatalog object and returns the object created by the server.
+func (c *Client) CreateServiceCatalog(ctx context.Context, in *ServiceCatalog) (*ServiceCatalog, error) {
+	out := &ServiceCatalog{}
+	if err := c.Create(ctx, "serviceCatalogManagement", "serviceCatalog", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceCatalog retrieves the ServiceCatalog with the given id.
+func (c *Client) GetServiceCatalog(ctx context.Context, id string, opts *GetOptions) (*ServiceCatalog, error) {
+	out := &ServiceCatalog{}
+	if err := c.Get(ctx, "serviceCatalogManagement", "serviceCatalog", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServiceCatalogs retrieves the ServiceCatalog objects matching the options.
+func (c *Client) ListServiceCatalogs(ctx context.Context, opts *ListOptions) ([]ServiceCatalog, error) {
+	var out []ServiceCatalog
+	if err := c.List(ctx, "serviceCatalogManagement", "serviceCatalog", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchServiceCatalog updates the attributes present in patch of the ServiceCatalog with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchServiceCatalog(ctx context.Context, id string, patch *ServiceCatalog) (*ServiceCatalog, error) {
+	out := &ServiceCatalog{}
+	if err := c.Patch(ctx, "serviceCatalogManagement", "serviceCatalog", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteServiceCatalog deletes the ServiceCatalog with the given id.
+func (c *Client) DeleteServiceCatalog(ctx context.Context, id string) error {
+	return c.Delete(ctx, "serviceCatalogManagement", "serviceCatalog", id)
+}
+
+// ServiceCategory operations (serviceCatalogManagement/serviceCategory)
+
+// CreateServiceCategory creates a new ServiceCategory object and returns the object created by the server.
+func (c *Client) CreateServiceCategory(ctx context.Context, in *ServiceCategory) (*ServiceCategory, error) {
+	out := &ServiceCategory{}
+	if err := c.Create(ctx, "serviceCatalogManagement", "serviceCategory", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceCategory retrieves the ServiceCategory with the given id.
+func (c *Client) GetServiceCategory(ctx context.Context, id string, opts *GetOptions) (*ServiceCategory, error) {
+	out := &ServiceCategory{}
+	if err := c.Get(ctx, "serviceCatalogManagement", "serviceCategory", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServiceCategories retrieves the ServiceCategory objects matching the options.
+func (c *Client) ListServiceCategories(ctx context.Context, opts *ListOptions) ([]ServiceCategory, error) {
+	var out []ServiceCategory
+	if err := c.List(ctx, "serviceCatalogManagement", "serviceCategory", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchServiceCategory updates the attributes present in patch of the ServiceCategory with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchServiceCategory(ctx context.Context, id string, patch *ServiceCategory) (*ServiceCategory, error) {
+	out := &ServiceCategory{}
+	if err := c.Patch(ctx, "serviceCatalogManagement", "serviceCategory", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteServiceCategory deletes the ServiceCategory with the given id.
+func (c *Client) DeleteServiceCategory(ctx context.Context, id string) error {
+	return c.Delete(ctx, "serviceCatalogManagement", "serviceCategory", id)
+}
+
+// ServiceSpecification operations (serviceCatalogManagement/serviceSpecification)
+
+// CreateServiceSpecification creates a new ServiceSpecification object and returns the object created by the server.
+func (c *Client) CreateServiceSpecification(ctx context.Context, in *ServiceSpecification) (*ServiceSpecification, error) {
+	out := &ServiceSpecification{}
+	if err := c.Create(ctx, "serviceCatalogManagement", "serviceSpecification", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceSpecification retrieves the ServiceSpecification with the given id.
+func (c *Client) GetServiceSpecification(ctx context.Context, id string, opts *GetOptions) (*ServiceSpecification, error) {
+	out := &ServiceSpecification{}
+	if err := c.Get(ctx, "serviceCatalogManagement", "serviceSpecification", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServiceSpecifications retrieves the ServiceSpecification objects matching the options.
+func (c *Client) ListServiceSpecifications(ctx context.Context, opts *ListOptions) ([]ServiceSpecification, error) {
+	var out []ServiceSpecification
+	if err := c.List(ctx, "serviceCatalogManagement", "serviceSpecification", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchServiceSpecification updates the attributes present in patch of the ServiceSpecification with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchServiceSpecification(ctx context.Context, id string, patch *ServiceSpecification) (*ServiceSpecification, error) {
+	out := &ServiceSpecification{}
+	if err := c.Patch(ctx, "serviceCatalogManagement", "serviceSpecification", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteServiceSpecification deletes the ServiceSpecification with the given id.
+func (c *Client) DeleteServiceSpecification(ctx context.Context, id string) error {
+	return c.Delete(ctx, "serviceCatalogManagement", "serviceSpecification", id)
+}
+
+// Usage operations (usageManagement/usage)
+
+// CreateUsage creates a new Usage object and returns the object created by the server.
+func (c *Client) CreateUsage(ctx context.Context, in *Usage) (*Usage, error) {
+	out := &Usage{}
+	if err := c.Create(ctx, "usageManagement", "usage", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetUsage retrieves the Usage with the given id.
+func (c *Client) GetUsage(ctx context.Context, id string, opts *GetOptions) (*Usage, error) {
+	out := &Usage{}
+	if err := c.Get(ctx, "usageManagement", "usage", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListUsages retrieves the Usage objects matching the options.
+func (c *Client) ListUsages(ctx context.Context, opts *ListOptions) ([]Usage, error) {
+	var out []Usage
+	if err := c.List(ctx, "usageManagement", "usage", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchUsage updates the attributes present in patch of the Usage with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchUsage(ctx context.Context, id string, patch *Usage) (*Usage, error) {
+	out := &Usage{}
+	if err := c.Patch(ctx, "usageManagement", "usage", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteUsage deletes the Usage with the given id.
+func (c *Client) DeleteUsage(ctx context.Context, id string) error {
+	return c.Delete(ctx, "usageManagement", "usage", id)
+}
+
+// UsageSpecification operations (usageManagement/usageSpecification)
+
+// CreateUsageSpecification creates a new UsageSpecification object and returns the object created by the server.
+func (c *Client) CreateUsageSpecification(ctx context.Context, in *UsageSpecification) (*UsageSpecification, error) {
+	out := &UsageSpecification{}
+	if err := c.Create(ctx, "usageManagement", "usageSpecification", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetUsageSpecification retrieves the UsageSpecification with the given id.
+func (c *Client) GetUsageSpecification(ctx context.Context, id string, opts *GetOptions) (*UsageSpecification, error) {
+	out := &UsageSpecification{}
+	if err := c.Get(ctx, "usageManagement", "usageSpecification", id, opts, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListUsageSpecifications retrieves the UsageSpecification objects matching the options.
+func (c *Client) ListUsageSpecifications(ctx context.Context, opts *ListOptions) ([]UsageSpecification, error) {
+	var out []UsageSpecification
+	if err := c.List(ctx, "usageManagement", "usageSpecification", opts, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatchUsageSpecification updates the attributes present in patch of the UsageSpecification with the given id, and returns the updated object.
+// Attributes can be removed setting them to nil in the Extensions of the patch.
+func (c *Client) PatchUsageSpecification(ctx context.Context, id string, patch *UsageSpecification) (*UsageSpecification, error) {
+	out := &UsageSpecification{}
+	if err := c.Patch(ctx, "usageManagement", "usageSpecification", id, patch, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteUsageSpecification deletes the UsageSpecification with the given id.
+func (c *Client) DeleteUsageSpecification(ctx context.Context, id string) error {
+	return c.Delete(ctx, "usageManagement", "usageSpecification", id)
+}
@@ -0,0 +1,110 @@
+package tmfclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestCreateProductOfferingKeepsExtensions(t *testing.T) {
+	var gotPath, gotAuth, gotContentType string
+	var gotBody map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotContentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &gotBody)
+
+		gotBody["id"] = "urn:ngsi-ld:product-offering:1"
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(gotBody)
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithToken("secret"))
+
+	bundle := false
+	in := &ProductOffering{
+		AtType:          "ProductOffering",
+		Name:            "Cloud storage",
+		LifecycleStatus: "Launched",
+		IsBundle:        &bundle,
+		Category:        []CategoryRef{{AtType: "CategoryRef", ID: "urn:ngsi-ld:category:1"}},
+		Extensions:      map[string]any{"x-tier": "gold"},
+	}
+	out, err := c.CreateProductOffering(context.Background(), in)
+	if err != nil {
+		t.Fatalf("CreateProductOffering: %v", err)
+	}
+
+	if gotPath != "/tmf-api/productCatalogManagement/v5/productOffering" {
+		t.Errorf("unexpected path %s", gotPath)
+	}
+	if gotAuth != "Bearer secret" || gotContentType != "application/json" {
+		t.Errorf("unexpected headers: %q %q", gotAuth, gotContentType)
+	}
+	if gotBody["x-tier"] != "gold" || gotBody["isBundle"] != false {
+		t.Errorf("unexpected body sent: %v", gotBody)
+	}
+	if _, ok := gotBody["description"]; ok {
+		t.Errorf("empty attributes must not be sent: %v", gotBody)
+	}
+
+	if out.ID != "urn:ngsi-ld:product-offering:1" || out.Name != "Cloud storage" || len(out.Category) != 1 {
+		t.Errorf("unexpected object returned: %+v", out)
+	}
+	if out.Extensions["x-tier"] != "gold" || len(out.Extensions) != 1 {
+		t.Errorf("unexpected extensions: %v", out.Extensions)
+	}
+}
+
+func TestListAndErrors(t *testing.T) {
+	var gotQuery url.Values
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gotQuery = r.URL.Query()
+			w.Write([]byte(`[{"@type":"Category","id":"c1","name":"Storage"},{"@type":"Category","id":"c2","name":"Compute"}]`))
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"400","reason":"Bad Request","message":"invalid object","details":[{"pointer":"/name","message":"must be a string"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+
+	list, err := c.ListCategories(context.Background(), &ListOptions{
+		Fields: []string{"name"},
+		Limit:  10,
+		Sort:   []string{"-lastUpdate"},
+		Filter: url.Values{"lifecycleStatus": {"Launched"}},
+	})
+	if err != nil {
+		t.Fatalf("ListCategories: %v", err)
+	}
+	if len(list) != 2 || list[1].Name != "Compute" {
+		t.Fatalf("unexpected list: %+v", list)
+	}
+	if gotQuery.Get("fields") != "name" || gotQuery.Get("limit") != "10" || gotQuery.Get("sort") != "-lastUpdate" ||
+		gotQuery.Get("lifecycleStatus") != "Launched" || gotQuery.Has("offset") {
+		t.Fatalf("unexpected query: %v", gotQuery)
+	}
+
+	_, err = c.PatchCategory(context.Background(), "c1", &Category{Name: "Storage"})
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an API error, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || len(apiErr.Details) != 1 || apiErr.Details[0].Pointer != "/name" {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+}
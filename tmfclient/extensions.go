@@ -0,0 +1,51 @@
+package tmfclient
+
+import (
+	"encoding/json"
+)
+
+// TMForum objects can be extended with attributes not defined in the specs (described by @schemaLocation).
+// The generated resources keep them in their Extensions field, so they are not lost when an object
+// is retrieved, modified and sent back to the server.
+
+// unmarshalExtensions returns the attributes of the JSON object which are not in known
+func unmarshalExtensions(data []byte, known map[string]bool) (map[string]any, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	var ext map[string]any
+	for name, raw := range all {
+		if known[name] {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+		if ext == nil {
+			ext = make(map[string]any)
+		}
+		ext[name] = value
+	}
+	return ext, nil
+}
+
+// marshalWithExtensions marshals v, which must marshal to a JSON object, adding the extension attributes.
+// Attributes defined in v take precedence over extensions with the same name.
+func marshalWithExtensions(v any, ext map[string]any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(ext) == 0 {
+		return data, err
+	}
+	var all map[string]any
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for name, value := range ext {
+		if _, ok := all[name]; !ok {
+			all[name] = value
+		}
+	}
+	return json.Marshal(all)
+}
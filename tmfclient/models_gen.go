@@ -0,0 +1,4066 @@
+// Code generated by isbeoapi from the OpenAPI documents in oapiv5. DO NOT EDIT.
+
+package tmfclient
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AccountRef defines model for the AccountRef schema.
+// Account reference.
+type AccountRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// Agreement defines model for the Agreement schema.
+// An agreement represents a contract or arrangement, either written or verbal and sometimes enforceable by law, such as a service level agreement or a customer price agreement.
+type Agreement struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Authorizations of the agreement
+	AgreementAuthorization []AgreementAuthorization `json:"agreementAuthorization,omitempty"`
+	// The list of agreement items
+	AgreementItem   []AgreementItem `json:"agreementItem,omitempty"`
+	AgreementPeriod *TimePeriod     `json:"agreementPeriod,omitempty"`
+	// Relationships with other agreements
+	AgreementRelationship  []AgreementRelationship    `json:"agreementRelationship,omitempty"`
+	AgreementSpecification *AgreementSpecificationRef `json:"agreementSpecification,omitempty"`
+	// The type of the agreement.
+	AgreementType string `json:"agreementType,omitempty"`
+	// Characteristics of the agreement
+	Characteristic []Characteristic `json:"characteristic,omitempty"`
+	CompletionDate *TimePeriod      `json:"completionDate,omitempty"`
+	// Narrative that explains the agreement and details about it , such as why the agreement is taking place.
+	Description string `json:"description,omitempty"`
+	// The parties engaged in the agreement
+	EngagedParty []PartyRefOrPartyRoleRef `json:"engagedParty,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date at which the agreement was initialized
+	InitialDate *time.Time `json:"initialDate,omitempty"`
+	// A human-readable name for the agreement
+	Name string `json:"name,omitempty"`
+	// Parties related to the agreement
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// An overview and goals of the Agreement
+	StatementOfIntent string `json:"statementOfIntent,omitempty"`
+	// The current status of the agreement
+	Status string `json:"status,omitempty"`
+	// A string identifying the version of the agreement
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// agreementFields are the attributes defined in the Agreement schema
+var agreementFields = map[string]bool{
+	"@baseType":              true,
+	"@schemaLocation":        true,
+	"@type":                  true,
+	"agreementAuthorization": true,
+	"agreementItem":          true,
+	"agreementPeriod":        true,
+	"agreementRelationship":  true,
+	"agreementSpecification": true,
+	"agreementType":          true,
+	"characteristic":         true,
+	"completionDate":         true,
+	"description":            true,
+	"engagedParty":           true,
+	"href":                   true,
+	"id":                     true,
+	"initialDate":            true,
+	"name":                   true,
+	"relatedParty":           true,
+	"statementOfIntent":      true,
+	"status":                 true,
+	"version":                true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Agreement) UnmarshalJSON(data []byte) error {
+	type plain Agreement
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, agreementFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Agreement) MarshalJSON() ([]byte, error) {
+	type plain Agreement
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// AgreementAuthorization defines model for the AgreementAuthorization schema.
+// A business participant that is responsible for approving the agreement.
+type AgreementAuthorization struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// The date associated with the authorization state.
+	Date *time.Time `json:"date,omitempty"`
+	// Indication that represents whether the signature is a physical paper signature or a digital signature.
+	SignatureRepresentation string `json:"signatureRepresentation,omitempty"`
+	// Current status of the authorization, for example in process, approved, rejected.
+	State string `json:"state,omitempty"`
+}
+
+// AgreementItem defines model for the AgreementItem schema.
+// A part of the agreement expressed in terms of a product offering and possibly including specific terms and conditions.
+type AgreementItem struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Products covered by the agreement item
+	Product []ProductRef `json:"product,omitempty"`
+	// Product offerings covered by the agreement item
+	ProductOffering []ProductOfferingRef `json:"productOffering,omitempty"`
+	// Terms and conditions of the agreement item
+	TermOrCondition []AgreementTermOrCondition `json:"termOrCondition,omitempty"`
+}
+
+// AgreementItemRef defines model for the AgreementItemRef schema.
+// It's a Agreement item that has been executed previously.
+type AgreementItemRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Identifier of the agreement
+	AgreementItemId string `json:"agreementItemId,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// AgreementRef defines model for the AgreementRef schema.
+// Agreement reference.
+type AgreementRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// AgreementRelationship defines model for the AgreementRelationship schema.
+// A uni-directionmal relationship from this agreement to a target agreement.
+type AgreementRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType    string        `json:"@type,omitempty"`
+	Agreement *AgreementRef `json:"agreement,omitempty"`
+	// Type of relationship such as migration, substitution, dependency, exclusivity
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// AgreementSpecification defines model for the AgreementSpecification schema.
+// A template of an agreement that can be used when establishing partnerships
+type AgreementSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Attachments describing the agreement specification
+	Attachment []AttachmentRefOrValue `json:"attachment,omitempty"`
+	// A narrative that explains in detail what the agreement specification is about
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, this agreement specification is a grouping of other agreement specifications.
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Indicates the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the agreement specification
+	Name string `json:"name,omitempty"`
+	// Parties related to the agreement specification
+	RelatedParty    []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	ServiceCategory *CategoryRef                    `json:"serviceCategory,omitempty"`
+	// Characteristics of the agreement specification
+	SpecificationCharacteristic []CharacteristicSpecification `json:"specificationCharacteristic,omitempty"`
+	// Relationships with other agreement specifications
+	SpecificationRelationship []AgreementSpecificationRelationship `json:"specificationRelationship,omitempty"`
+	ValidFor                  *TimePeriod                          `json:"validFor,omitempty"`
+	// Agreement specification version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// agreementSpecificationFields are the attributes defined in the AgreementSpecification schema
+var agreementSpecificationFields = map[string]bool{
+	"@baseType":                   true,
+	"@schemaLocation":             true,
+	"@type":                       true,
+	"attachment":                  true,
+	"description":                 true,
+	"href":                        true,
+	"id":                          true,
+	"isBundle":                    true,
+	"lastUpdate":                  true,
+	"lifecycleStatus":             true,
+	"name":                        true,
+	"relatedParty":                true,
+	"serviceCategory":             true,
+	"specificationCharacteristic": true,
+	"specificationRelationship":   true,
+	"validFor":                    true,
+	"version":                     true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *AgreementSpecification) UnmarshalJSON(data []byte) error {
+	type plain AgreementSpecification
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, agreementSpecificationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o AgreementSpecification) MarshalJSON() ([]byte, error) {
+	type plain AgreementSpecification
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// AgreementSpecificationRef defines model for the AgreementSpecificationRef schema.
+// AgreementSpecification reference.
+type AgreementSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the agreement specification
+	Version string `json:"version,omitempty"`
+}
+
+// AgreementSpecificationRelationship defines model for the AgreementSpecificationRelationship schema.
+// A relationship between agreement specifications.
+type AgreementSpecificationRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Reference of the target agreement specification
+	Href string `json:"href,omitempty"`
+	// Unique identifier of the target agreement specification
+	ID string `json:"id,omitempty"`
+	// Type of relationship such as migration, substitution, dependency, exclusivity
+	RelationshipType string      `json:"relationshipType,omitempty"`
+	ValidFor         *TimePeriod `json:"validFor,omitempty"`
+}
+
+// AgreementTermOrCondition defines model for the AgreementTermOrCondition schema.
+// Aspects of the agreement not formally specified elsewhere in the agreement and that cannot be captured elsewhere in a formal notation, or automatically monitored and require a more human level of management.
+type AgreementTermOrCondition struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Text that explains the term or condition of the agreement
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the term or condition
+	ID       string      `json:"id,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// AllowedProductAction defines model for the AllowedProductAction schema.
+// Defines an action that can be taken on a product in the inventory as part of a product order.
+type AllowedProductAction struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// The name of the action
+	Action string `json:"action,omitempty"`
+	// A list of sales channels in which this action is allowed; for instance Remove might be allowed only in an assisted channel not in a self-service channel
+	Channel  []ChannelRef `json:"channel,omitempty"`
+	ValidFor *TimePeriod  `json:"validFor,omitempty"`
+}
+
+// AttachmentRefOrValue defines model for the AttachmentRefOrValue schema.
+// The polymorphic attributes @type, @schemaLocation & @referredType are related to the Attachment entity and not the AttachmentRefOrValue class itself
+type AttachmentRefOrValue struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// a business characterization of the purpose of the attachment, for example logo, instructionManual, contractCopy
+	AttachmentType string `json:"attachmentType,omitempty"`
+	// The actual contents of the attachment object, if embedded, encoded as base64
+	Content string `json:"content,omitempty"`
+	// A narrative text describing the content of the attachment
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// a technical characterization of the attachment content format using IETF Mime Types
+	MimeType string `json:"mimeType,omitempty"`
+	// The name of the attachment
+	Name string    `json:"name,omitempty"`
+	Size *Quantity `json:"size,omitempty"`
+	// Uniform Resource Locator, is a web page address (a subset of URI)
+	URL      string      `json:"url,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// BillingAccountRef defines model for the BillingAccountRef schema.
+// BillingAccount reference.
+type BillingAccountRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Indicates whether the account follows a specific payment option such as prepaid or postpaid
+	RatingType string `json:"ratingType,omitempty"`
+}
+
+// BundledGroupProductOffering defines model for the BundledGroupProductOffering schema.
+// A group of product offerings that can be chosen for instantiation of children of the parent product offering, for example a list of channels for selection under a TV offering.
+type BundledGroupProductOffering struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Child groups of product offerings, to enable hierarchical sub-grouping.
+	BundledGroupProductOffering       []BundledGroupProductOffering      `json:"bundledGroupProductOffering,omitempty"`
+	BundledGroupProductOfferingOption *BundledGroupProductOfferingOption `json:"bundledGroupProductOfferingOption,omitempty"`
+	// Child offerings, from which instances can be created as direct or hierarchically indirect children of the parent offering.
+	BundledProductOffering []BundledProductOffering `json:"bundledProductOffering,omitempty"`
+	// Locally unique identifier of the group, useful in case the parent product offering or group includes multiple groups.
+	ID string `json:"id,omitempty"`
+	// The name of the group of child offerings.
+	Name string `json:"name,omitempty"`
+}
+
+// BundledGroupProductOfferingOption defines model for the BundledGroupProductOfferingOption schema.
+// Defines for a BundledProductOfferingGroup (i.e. a group of multiple child offerings of a parent product offering), how many instances from the child offerings can be chosen in total.
+type BundledGroupProductOfferingOption struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// The minimum total number of instances of the child offerings directly of hierarchically in the group that should be instantiated
+	NumberRelOfferLowerLimit *int64 `json:"numberRelOfferLowerLimit,omitempty"`
+	// The maximum total number of instances of the child offerings directly of hierarchically in the group that should be instantiated
+	NumberRelOfferUpperLimit *int64 `json:"numberRelOfferUpperLimit,omitempty"`
+}
+
+// BundledProductOffering defines model for the BundledProductOffering schema.
+// Represents a containment of a product offering within another product offering, including specification of cardinality (e.g. is the bundled offering mandatory, how many times can it be instantiated in the parent product, etc.).
+type BundledProductOffering struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType                       string                        `json:"@type,omitempty"`
+	BundledProductOfferingOption *BundledProductOfferingOption `json:"bundledProductOfferingOption,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the product offering
+	Version string `json:"version,omitempty"`
+}
+
+// BundledProductOfferingOption defines model for the BundledProductOfferingOption schema.
+// A set of numbers that specifies the lower and upper limits for a ProductOffering that can be procured as part of the related BundledProductOffering.
+type BundledProductOfferingOption struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Default number of produc offereings that should be procured as part of the related BundledProductOffering
+	NumberRelOfferDefault *int64 `json:"numberRelOfferDefault,omitempty"`
+	// lower limit for a product offering that can be procured as part of the related BundledProductOffering
+	NumberRelOfferLowerLimit *int64 `json:"numberRelOfferLowerLimit,omitempty"`
+	// upper limit for a product offering that can be procured as part of the related BundledProductOffering
+	NumberRelOfferUpperLimit *int64 `json:"numberRelOfferUpperLimit,omitempty"`
+}
+
+// BundledProductOfferingPriceRelationship defines model for the BundledProductOfferingPriceRelationship schema.
+// This represents a bundling pricing relationship, allowing a price to be composed of multiple other prices (e.g. a recurring charge and a onetime charge).
+type BundledProductOfferingPriceRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the referred product offering price.
+	Version string `json:"version,omitempty"`
+}
+
+// BundledProductSpecification defines model for the BundledProductSpecification schema.
+// A type of ProductSpecification that belongs to a grouping of ProductSpecifications made available to the market.
+type BundledProductSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Reference of the product specification
+	Href string `json:"href,omitempty"`
+	// Unique identifier of the product specification
+	ID string `json:"id,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the product specification
+	Name string `json:"name,omitempty"`
+	// Version of the product specification
+	Version string `json:"version,omitempty"`
+}
+
+// CancelProductOrder defines model for the CancelProductOrder schema.
+// Request for cancellation an existing product order
+type CancelProductOrder struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Reason why the order is cancelled.
+	CancellationReason string `json:"cancellationReason,omitempty"`
+	// Date when the cancellation request was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Date when the order is cancelled.
+	EffectiveCancellationDate *time.Time `json:"effectiveCancellationDate,omitempty"`
+	// A description of the error, if the cancellation failed
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID           string           `json:"id,omitempty"`
+	ProductOrder *ProductOrderRef `json:"productOrder,omitempty"`
+	// Date when the submitter wants the order to be cancelled
+	RequestedCancellationDate *time.Time `json:"requestedCancellationDate,omitempty"`
+	State                     string     `json:"state,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// cancelProductOrderFields are the attributes defined in the CancelProductOrder schema
+var cancelProductOrderFields = map[string]bool{
+	"@baseType":                 true,
+	"@schemaLocation":           true,
+	"@type":                     true,
+	"cancellationReason":        true,
+	"creationDate":              true,
+	"effectiveCancellationDate": true,
+	"errorMessage":              true,
+	"href":                      true,
+	"id":                        true,
+	"productOrder":              true,
+	"requestedCancellationDate": true,
+	"state":                     true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *CancelProductOrder) UnmarshalJSON(data []byte) error {
+	type plain CancelProductOrder
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, cancelProductOrderFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o CancelProductOrder) MarshalJSON() ([]byte, error) {
+	type plain CancelProductOrder
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// Category defines model for the Category schema.
+// The category resource is used to group product offerings, service and resource candidates in logical containers.
+type Category struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of the category
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, this Boolean indicates that the category is a root of categories
+	IsRoot *bool `json:"isRoot,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the category
+	Name   string       `json:"name,omitempty"`
+	Parent *CategoryRef `json:"parent,omitempty"`
+	// List of product offerings that are referred to by the category
+	ProductOffering []ProductOfferingRef `json:"productOffering,omitempty"`
+	// The category resource is used to group product offerings, service and resource candidates in logical containers.
+	SubCategory []CategoryRef `json:"subCategory,omitempty"`
+	ValidFor    *TimePeriod   `json:"validFor,omitempty"`
+	// Category version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// categoryFields are the attributes defined in the Category schema
+var categoryFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"description":     true,
+	"href":            true,
+	"id":              true,
+	"isRoot":          true,
+	"lastUpdate":      true,
+	"lifecycleStatus": true,
+	"name":            true,
+	"parent":          true,
+	"productOffering": true,
+	"subCategory":     true,
+	"validFor":        true,
+	"version":         true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Category) UnmarshalJSON(data []byte) error {
+	type plain Category
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, categoryFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Category) MarshalJSON() ([]byte, error) {
+	type plain Category
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// CategoryRef defines model for the CategoryRef schema.
+// Reference to a category in the catalog.
+type CategoryRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the category
+	Version string `json:"version,omitempty"`
+}
+
+// ChannelRef defines model for the ChannelRef schema.
+// The channel to which the resource reference to.
+type ChannelRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// Characteristic defines model for the Characteristic schema.
+// Describes a given characteristic of an object or entity through a name/value pair.
+type Characteristic struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType                     string                       `json:"@type,omitempty"`
+	CharacteristicRelationship []CharacteristicRelationship `json:"characteristicRelationship,omitempty"`
+	// Unique identifier of the characteristic
+	ID string `json:"id,omitempty"`
+	// Name of the characteristic
+	Name string `json:"name,omitempty"`
+	// Data type of the value of the characteristic
+	ValueType string `json:"valueType,omitempty"`
+}
+
+// CharacteristicRelationship defines model for the CharacteristicRelationship schema.
+// Another Characteristic that is related to the current Characteristic;
+type CharacteristicRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Unique identifier of the characteristic
+	ID string `json:"id,omitempty"`
+	// The type of relationship
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// CharacteristicSpecification defines model for the CharacteristicSpecification schema.
+// This class defines a characteristic specification.
+type CharacteristicSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// This (optional) field provides a link to the schema describing the value type.
+	AtValueSchemaLocation string `json:"@valueSchemaLocation,omitempty"`
+	// An aggregation, migration, substitution, dependency or exclusivity relationship between/among Specification Characteristics.
+	CharSpecRelationship []CharacteristicSpecificationRelationship `json:"charSpecRelationship,omitempty"`
+	// A CharacteristicValueSpecification object is used to define a set of attributes, each of which can be assigned to a corresponding set of attributes in a CharacteristicSpecification object.
+	CharacteristicValueSpecification []CharacteristicValueSpecification `json:"characteristicValueSpecification,omitempty"`
+	// If true, the Boolean indicates that the target Characteristic is configurable
+	Configurable *bool `json:"configurable,omitempty"`
+	// A narrative that explains the CharacteristicSpecification.
+	Description string `json:"description,omitempty"`
+	// An indicator that specifies that the values for the characteristic can be extended by adding new values when instantiating a characteristic for a resource.
+	Extensible *bool `json:"extensible,omitempty"`
+	// Unique ID for the characteristic
+	ID string `json:"id,omitempty"`
+	// Specifies if the value of this characteristic is unique across all entities instantiated from the specification that uses this characteristc.
+	IsUnique *bool `json:"isUnique,omitempty"`
+	// The maximum number of instances a CharacteristicValue can take on.
+	MaxCardinality *int64 `json:"maxCardinality,omitempty"`
+	// The minimum number of instances a CharacteristicValue can take on.
+	MinCardinality *int64 `json:"minCardinality,omitempty"`
+	// A word, term, or phrase by which this characteristic specification is known and distinguished from other characteristic specifications.
+	Name string `json:"name,omitempty"`
+	// A rule or principle represented in regular expression used to derive the value of a characteristic value.
+	Regex    string      `json:"regex,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+	// A kind of value that the characteristic can take on, such as numeric, text and so forth
+	ValueType string `json:"valueType,omitempty"`
+}
+
+// CharacteristicSpecificationRelationship defines model for the CharacteristicSpecificationRelationship schema.
+// An aggregation, migration, substitution, dependency or exclusivity relationship between/among Characteristic specifications.
+type CharacteristicSpecificationRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Unique identifier of the characteristic within the specification
+	CharacteristicSpecificationId string `json:"characteristicSpecificationId,omitempty"`
+	// Name of the target characteristic within the specification
+	Name string `json:"name,omitempty"`
+	// Hyperlink reference to the parent specification containing the target characteristic
+	ParentSpecificationHref string `json:"parentSpecificationHref,omitempty"`
+	// Unique identifier of the parent specification containing the target characteristic
+	ParentSpecificationId string `json:"parentSpecificationId,omitempty"`
+	// Type of relationship such as aggregation, migration, substitution, dependency, exclusivity
+	RelationshipType string      `json:"relationshipType,omitempty"`
+	ValidFor         *TimePeriod `json:"validFor,omitempty"`
+}
+
+// CharacteristicValueSpecification defines model for the CharacteristicValueSpecification schema.
+// specification of a value (number or text or an object) that can be assigned to a Characteristic.
+type CharacteristicValueSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// If true, the Boolean Indicates if the value is the default value for a characteristic
+	IsDefault *bool `json:"isDefault,omitempty"`
+	// An indicator that specifies the inclusion or exclusion of the valueFrom and valueTo attributes.
+	RangeInterval string `json:"rangeInterval,omitempty"`
+	// A regular expression constraint for given value
+	Regex string `json:"regex,omitempty"`
+	// A length, surface, volume, dry measure, liquid measure, money, weight, time, and the like.
+	UnitOfMeasure string      `json:"unitOfMeasure,omitempty"`
+	ValidFor      *TimePeriod `json:"validFor,omitempty"`
+	// The low range value that a characteristic can take on
+	ValueFrom *int64 `json:"valueFrom,omitempty"`
+	// The upper range value that a characteristic can take on
+	ValueTo *int64 `json:"valueTo,omitempty"`
+	// A kind of value that the characteristic value can take on, such as numeric, text and so forth
+	ValueType string `json:"valueType,omitempty"`
+}
+
+// ContactMedium defines model for the ContactMedium schema.
+// Indicates the contact medium that could be used to contact the party.
+type ContactMedium struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Type of the contact medium to qualifiy it like pro email / personal email.
+	ContactType string `json:"contactType,omitempty"`
+	// Identifier for this contact medium.
+	ID string `json:"id,omitempty"`
+	// If true, indicates that is the preferred contact medium
+	Preferred *bool       `json:"preferred,omitempty"`
+	ValidFor  *TimePeriod `json:"validFor,omitempty"`
+}
+
+// CreditProfile defines model for the CreditProfile schema.
+// Credit profile for the party (containing credit scoring, ...).
+type CreditProfile struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// The date the profile was established
+	CreditProfileDate *time.Time `json:"creditProfileDate,omitempty"`
+	// This is an integer whose value is used to rate the risk
+	CreditRiskRating *int64 `json:"creditRiskRating,omitempty"`
+	// A measure of a person or organizations creditworthiness calculated on the basis of a combination of factors such as their income and credit history
+	CreditScore *int64 `json:"creditScore,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID       string      `json:"id,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// Disability defines model for the Disability schema.
+// Lack or inadequate strength or ability.
+type Disability struct {
+	// Code of the disability
+	DisabilityCode string `json:"disabilityCode,omitempty"`
+	// Name of the disability
+	DisabilityName string      `json:"disabilityName,omitempty"`
+	ValidFor       *TimePeriod `json:"validFor,omitempty"`
+}
+
+// Duration defines model for the Duration schema.
+// A time interval in a given unit of time
+type Duration struct {
+	// Time interval (number of seconds, minutes, hours, etc.)
+	Amount *int64 `json:"amount,omitempty"`
+	// Unit of time (seconds, minutes, hours, etc.)
+	Units string `json:"units,omitempty"`
+}
+
+// ExportJob defines model for the ExportJob schema.
+// Represents a task used to export resources to a file
+type ExportJob struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Data at which the job was completed
+	CompletionDate *time.Time `json:"completionDate,omitempty"`
+	// The format of the exported data
+	ContentType string `json:"contentType,omitempty"`
+	// Date at which the job was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Path to file or stream where errors encountered during the job processing can be written
+	ErrorLog string `json:"errorLog,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// URL of the root resource acting as the source for streaming content to the file specified by the export job
+	Path string `json:"path,omitempty"`
+	// Used to scope the exported data
+	Query  string `json:"query,omitempty"`
+	Status string `json:"status,omitempty"`
+	// URL of the file containing the data to be exported
+	URL string `json:"url,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// exportJobFields are the attributes defined in the ExportJob schema
+var exportJobFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"completionDate":  true,
+	"contentType":     true,
+	"creationDate":    true,
+	"errorLog":        true,
+	"href":            true,
+	"id":              true,
+	"path":            true,
+	"query":           true,
+	"status":          true,
+	"url":             true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ExportJob) UnmarshalJSON(data []byte) error {
+	type plain ExportJob
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, exportJobFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ExportJob) MarshalJSON() ([]byte, error) {
+	type plain ExportJob
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ExternalIdentifier defines model for the ExternalIdentifier schema.
+// An identification of an entity that is owned by or originates in a software system different from the current system, for example a ProductOrder handed off from a commerce platform into an order handling system.
+type ExternalIdentifier struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Type of the identification, typically would be the type of the entity within the external system
+	ExternalIdentifierType string `json:"externalIdentifierType,omitempty"`
+	// identification of the entity within the external system.
+	ID string `json:"id,omitempty"`
+	// Name of the external system that owns the entity.
+	Owner string `json:"owner,omitempty"`
+}
+
+// ImportJob defines model for the ImportJob schema.
+// Represents a task used to import resources from a file
+type ImportJob struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Date at which the job was completed
+	CompletionDate *time.Time `json:"completionDate,omitempty"`
+	// Indicates the format of the imported data
+	ContentType string `json:"contentType,omitempty"`
+	// Date at which the job was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Path to file or stream where errors encountered during the job processing can be written
+	ErrorLog string `json:"errorLog,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// URL of the root resource where the content of the file specified by the import job must be applied
+	Path   string `json:"path,omitempty"`
+	Status string `json:"status,omitempty"`
+	// URL of the file containing the data to be imported
+	URL string `json:"url,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// importJobFields are the attributes defined in the ImportJob schema
+var importJobFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"completionDate":  true,
+	"contentType":     true,
+	"creationDate":    true,
+	"errorLog":        true,
+	"href":            true,
+	"id":              true,
+	"path":            true,
+	"status":          true,
+	"url":             true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ImportJob) UnmarshalJSON(data []byte) error {
+	type plain ImportJob
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, importJobFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ImportJob) MarshalJSON() ([]byte, error) {
+	type plain ImportJob
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// Individual defines model for the Individual schema.
+// Individual represents a single human being (a man, woman or child).
+type Individual struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// e.g. Baron, Graf, Earl
+	AristocraticTitle string `json:"aristocraticTitle,omitempty"`
+	// Birth date
+	BirthDate *time.Time `json:"birthDate,omitempty"`
+	// List of means for contacting the party, e.g. mobile phone, email address
+	ContactMedium []ContactMedium `json:"contactMedium,omitempty"`
+	// Country where the individual was born
+	CountryOfBirth string `json:"countryOfBirth,omitempty"`
+	// List of credit profiles and scores for the party, typically received from an external credit broker
+	CreditRating []PartyCreditProfile `json:"creditRating,omitempty"`
+	// Date of death
+	DeathDate *time.Time `json:"deathDate,omitempty"`
+	// List of disabilities suffered by the individual
+	Disability []Disability `json:"disability,omitempty"`
+	// List of identifiers of the Party in an external system, for example when party information is imported from a commerce system
+	ExternalReference []ExternalIdentifier `json:"externalReference,omitempty"`
+	// Contains the non-chosen or inherited name.
+	FamilyName string `json:"familyName,omitempty"`
+	// Family name prefix
+	FamilyNamePrefix string `json:"familyNamePrefix,omitempty"`
+	// A fully formatted name in one string with all of its pieces in their proper place and all of the necessary punctuation.
+	FormattedName string `json:"formattedName,omitempty"`
+	// Gender
+	Gender string `json:"gender,omitempty"`
+	// e.g..
+	Generation string `json:"generation,omitempty"`
+	// First name of the individual
+	GivenName string `json:"givenName,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// List of official identifications issued to the individual, such as passport, driving licence, social security number
+	IndividualIdentification []IndividualIdentification `json:"individualIdentification,omitempty"`
+	// List of national languages known by the individual
+	LanguageAbility []LanguageAbility `json:"languageAbility,omitempty"`
+	// Legal name or birth name (name one has for official purposes)
+	LegalName string `json:"legalName,omitempty"`
+	// Temporary current location of the individual (may be used if the individual has approved its sharing)
+	Location string `json:"location,omitempty"`
+	// Marital status (married, divorced, widow ...)
+	MaritalStatus string `json:"maritalStatus,omitempty"`
+	// Middles name or initial
+	MiddleName string `json:"middleName,omitempty"`
+	// Full name flatten (first, middle, and last names) - this is the name that is expected to be presented in reference data types such as PartyRef, RelatedParty, etc.
+	Name string `json:"name,omitempty"`
+	// Nationality
+	Nationality string `json:"nationality,omitempty"`
+	// List of other names by which this individual is known
+	OtherName []OtherNameIndividual `json:"otherName,omitempty"`
+	// List of additional characteristics that a Party can take on.
+	PartyCharacteristic []Characteristic `json:"partyCharacteristic,omitempty"`
+	// Reference to the place where the individual was born
+	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+	// Contains the chosen name by which the individual prefers to be addressed.
+	PreferredGivenName string `json:"preferredGivenName,omitempty"`
+	// List of parties and/or party roles related to this party
+	RelatedParty []RelatedPartyOrPartyRole `json:"relatedParty,omitempty"`
+	// List of skills exhibited by the individual
+	Skill  []Skill `json:"skill,omitempty"`
+	Status string  `json:"status,omitempty"`
+	// List of tax exemptions granted to the party.
+	TaxExemptionCertificate []TaxExemptionCertificate `json:"taxExemptionCertificate,omitempty"`
+	// Useful for titles (aristocratic, social,...) Pr, Dr, Sir, ...
+	Title string `json:"title,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// individualFields are the attributes defined in the Individual schema
+var individualFields = map[string]bool{
+	"@baseType":                true,
+	"@schemaLocation":          true,
+	"@type":                    true,
+	"aristocraticTitle":        true,
+	"birthDate":                true,
+	"contactMedium":            true,
+	"countryOfBirth":           true,
+	"creditRating":             true,
+	"deathDate":                true,
+	"disability":               true,
+	"externalReference":        true,
+	"familyName":               true,
+	"familyNamePrefix":         true,
+	"formattedName":            true,
+	"gender":                   true,
+	"generation":               true,
+	"givenName":                true,
+	"href":                     true,
+	"id":                       true,
+	"individualIdentification": true,
+	"languageAbility":          true,
+	"legalName":                true,
+	"location":                 true,
+	"maritalStatus":            true,
+	"middleName":               true,
+	"name":                     true,
+	"nationality":              true,
+	"otherName":                true,
+	"partyCharacteristic":      true,
+	"placeOfBirth":             true,
+	"preferredGivenName":       true,
+	"relatedParty":             true,
+	"skill":                    true,
+	"status":                   true,
+	"taxExemptionCertificate":  true,
+	"title":                    true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Individual) UnmarshalJSON(data []byte) error {
+	type plain Individual
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, individualFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Individual) MarshalJSON() ([]byte, error) {
+	type plain Individual
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// IndividualIdentification defines model for the IndividualIdentification schema.
+// Represents our registration of information used as proof of identity by an individual (passport, national identity card, drivers license, social security number, birth certificate)
+type IndividualIdentification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType     string                `json:"@type,omitempty"`
+	Attachment *AttachmentRefOrValue `json:"attachment,omitempty"`
+	// Identifier
+	IdentificationId string `json:"identificationId,omitempty"`
+	// Identification type (passport, national identity card, drivers license, social security number, birth certificate)
+	IdentificationType string `json:"identificationType,omitempty"`
+	// Authority which has issued the identifier, such as: social security, town hall
+	IssuingAuthority string `json:"issuingAuthority,omitempty"`
+	// Date at which the identifier was issued
+	IssuingDate *time.Time  `json:"issuingDate,omitempty"`
+	ValidFor    *TimePeriod `json:"validFor,omitempty"`
+}
+
+// IntentSpecificationRef defines model for the IntentSpecificationRef schema.
+type IntentSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// LanguageAbility defines model for the LanguageAbility schema.
+// Ability of an individual to understand or converse in a language.
+type LanguageAbility struct {
+	// A “true” value specifies whether the language is considered by the individual as his favourite one
+	IsFavouriteLanguage *bool `json:"isFavouriteLanguage,omitempty"`
+	// Language code (RFC 5646)
+	LanguageCode string `json:"languageCode,omitempty"`
+	// Language name
+	LanguageName string `json:"languageName,omitempty"`
+	// Listening proficiency evaluated for this language
+	ListeningProficiency string `json:"listeningProficiency,omitempty"`
+	// Reading proficiency evaluated for this language
+	ReadingProficiency string `json:"readingProficiency,omitempty"`
+	// Speaking proficiency evaluated for this language
+	SpeakingProficiency string      `json:"speakingProficiency,omitempty"`
+	ValidFor            *TimePeriod `json:"validFor,omitempty"`
+	// Writing proficiency evaluated for this language
+	WritingProficiency string `json:"writingProficiency,omitempty"`
+}
+
+// MarketSegmentRef defines model for the MarketSegmentRef schema.
+// provides references to the corresponding market segment as target of product offerings.
+type MarketSegmentRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// Money defines model for the Money schema.
+// A base / value business entity used to represent money
+type Money struct {
+	// Currency (ISO4217 norm uses 3 letters to define the currency)
+	Unit string `json:"unit,omitempty"`
+	// A signed floating point number, the meaning of the sign is according to the context of the API that uses this Data type
+	Value *float64 `json:"value,omitempty"`
+}
+
+// Note defines model for the Note schema.
+// Extra information about a given entity
+type Note struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Author of the note
+	Author string `json:"author,omitempty"`
+	// Date of the note
+	Date *time.Time `json:"date,omitempty"`
+	// Identifier of the note within its containing entity
+	ID string `json:"id,omitempty"`
+	// Text of the note
+	Text string `json:"text,omitempty"`
+}
+
+// OrderItemRelationship defines model for the OrderItemRelationship schema.
+// Used to describe relationship between Order item.
+type OrderItemRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Id of the related Order item (must be in the same Order)
+	ID string `json:"id,omitempty"`
+	// Relationship type as relies on, bundles, etc...
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// OrderPrice defines model for the OrderPrice schema.
+// An amount, usually of money, that represents the actual price paid by the Customer for this item or this order
+type OrderPrice struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of price
+	Description string `json:"description,omitempty"`
+	// A short descriptive name such as "Subscription price"
+	Name  string `json:"name,omitempty"`
+	Price *Price `json:"price,omitempty"`
+	// A list of price alterations applied to the price
+	PriceAlteration []PriceAlteration `json:"priceAlteration,omitempty"`
+	// A category that describes the price, such as recurring, discount, allowance, penalty, and so forth
+	PriceType            string                   `json:"priceType,omitempty"`
+	ProductOfferingPrice *ProductOfferingPriceRef `json:"productOfferingPrice,omitempty"`
+	// Could be month, week...
+	RecurringChargePeriod string `json:"recurringChargePeriod,omitempty"`
+	// Could be minutes, GB...
+	UnitOfMeasure string `json:"unitOfMeasure,omitempty"`
+}
+
+// Organization defines model for the Organization schema.
+// Organization represents a group of people identified by shared interests or purpose.
+type Organization struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of means for contacting the party, e.g. mobile phone, email address
+	ContactMedium []ContactMedium `json:"contactMedium,omitempty"`
+	// List of credit profiles and scores for the party, typically received from an external credit broker
+	CreditRating []PartyCreditProfile `json:"creditRating,omitempty"`
+	ExistsDuring *TimePeriod          `json:"existsDuring,omitempty"`
+	// List of identifiers of the Party in an external system, for example when party information is imported from a commerce system
+	ExternalReference []ExternalIdentifier `json:"externalReference,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If value is true, the organization is the head office
+	IsHeadOffice *bool `json:"isHeadOffice,omitempty"`
+	// If value is true, the organization is a legal entity known by a national referential.
+	IsLegalEntity *bool `json:"isLegalEntity,omitempty"`
+	// Organization name (department name for example)
+	Name string `json:"name,omitempty"`
+	// Type of the name : Co, Inc, Ltd, etc.
+	NameType string `json:"nameType,omitempty"`
+	// List of organizations that are contained within this organization.
+	OrganizationChildRelationship []OrganizationChildRelationship `json:"organizationChildRelationship,omitempty"`
+	// List of official identifiers given to the organization, for example company number in the registry of companies
+	OrganizationIdentification     []OrganizationIdentification    `json:"organizationIdentification,omitempty"`
+	OrganizationParentRelationship *OrganizationParentRelationship `json:"organizationParentRelationship,omitempty"`
+	// Type of Organization (company, department...)
+	OrganizationType string `json:"organizationType,omitempty"`
+	// List of additional names by which the organization is known
+	OtherName []OtherNameOrganization `json:"otherName,omitempty"`
+	// List of additional characteristics that a Party can take on.
+	PartyCharacteristic []Characteristic `json:"partyCharacteristic,omitempty"`
+	// List of parties and/or party roles related to this party
+	RelatedParty []RelatedPartyOrPartyRole `json:"relatedParty,omitempty"`
+	Status       string                    `json:"status,omitempty"`
+	// List of tax exemptions granted to the party.
+	TaxExemptionCertificate []TaxExemptionCertificate `json:"taxExemptionCertificate,omitempty"`
+	// Name that the organization (unit) trades under
+	TradingName string `json:"tradingName,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// organizationFields are the attributes defined in the Organization schema
+var organizationFields = map[string]bool{
+	"@baseType":                      true,
+	"@schemaLocation":                true,
+	"@type":                          true,
+	"contactMedium":                  true,
+	"creditRating":                   true,
+	"existsDuring":                   true,
+	"externalReference":              true,
+	"href":                           true,
+	"id":                             true,
+	"isHeadOffice":                   true,
+	"isLegalEntity":                  true,
+	"name":                           true,
+	"nameType":                       true,
+	"organizationChildRelationship":  true,
+	"organizationIdentification":     true,
+	"organizationParentRelationship": true,
+	"organizationType":               true,
+	"otherName":                      true,
+	"partyCharacteristic":            true,
+	"relatedParty":                   true,
+	"status":                         true,
+	"taxExemptionCertificate":        true,
+	"tradingName":                    true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	type plain Organization
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, organizationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Organization) MarshalJSON() ([]byte, error) {
+	type plain Organization
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// OrganizationChildRelationship defines model for the OrganizationChildRelationship schema.
+// Child references of an organization in a structure of organizations.
+type OrganizationChildRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType       string           `json:"@type,omitempty"`
+	Organization *OrganizationRef `json:"organization,omitempty"`
+	// Type of the relationship.
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// OrganizationIdentification defines model for the OrganizationIdentification schema.
+// Represents our registration of information used as proof of identity by an organization
+type OrganizationIdentification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType     string                `json:"@type,omitempty"`
+	Attachment *AttachmentRefOrValue `json:"attachment,omitempty"`
+	// Identifier
+	IdentificationId string `json:"identificationId,omitempty"`
+	// Type of identification information used to identify the company in a country or internationally
+	IdentificationType string `json:"identificationType,omitempty"`
+	// Authority which has issued the identifier (chamber of commerce...)
+	IssuingAuthority string `json:"issuingAuthority,omitempty"`
+	// Date at which the identifier was issued
+	IssuingDate *time.Time  `json:"issuingDate,omitempty"`
+	ValidFor    *TimePeriod `json:"validFor,omitempty"`
+}
+
+// OrganizationParentRelationship defines model for the OrganizationParentRelationship schema.
+// Parent references of an organization in a structure of organizations.
+type OrganizationParentRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType       string           `json:"@type,omitempty"`
+	Organization *OrganizationRef `json:"organization,omitempty"`
+	// Type of the relationship.
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// OrganizationRef defines model for the OrganizationRef schema.
+type OrganizationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// OtherNameIndividual defines model for the OtherNameIndividual schema.
+// Keeps track of other names, for example the old name of a woman before marriage or an artist name.
+type OtherNameIndividual struct {
+	// e.g. Baron, Graf, Earl, etc.
+	AristocraticTitle string `json:"aristocraticTitle,omitempty"`
+	// Contains the non-chosen or inherited name.
+	FamilyName string `json:"familyName,omitempty"`
+	// Family name prefix
+	FamilyNamePrefix string `json:"familyNamePrefix,omitempty"`
+	// .
+	FormattedName string `json:"formattedName,omitempty"`
+	// Full name flatten (first, middle, and last names)
+	FullName string `json:"fullName,omitempty"`
+	// e.g. Sr, Jr, etc.
+	Generation string `json:"generation,omitempty"`
+	// First name
+	GivenName string `json:"givenName,omitempty"`
+	// Legal name or birth name (name one has for official purposes)
+	LegalName string `json:"legalName,omitempty"`
+	// Middle name or initial
+	MiddleName string `json:"middleName,omitempty"`
+	// Contains the chosen name by which the person prefers to be addressed.
+	PreferredGivenName string `json:"preferredGivenName,omitempty"`
+	// Use for titles (aristrocatic, social, ...): Pr, Dr, Sir,....
+	Title    string      `json:"title,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// OtherNameOrganization defines model for the OtherNameOrganization schema.
+// Keeps track of other names, for example the old name of an organization.
+type OtherNameOrganization struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Organization name (department name for example)
+	Name string `json:"name,omitempty"`
+	// Co.
+	NameType string `json:"nameType,omitempty"`
+	// The name that the organization trades under
+	TradingName string      `json:"tradingName,omitempty"`
+	ValidFor    *TimePeriod `json:"validFor,omitempty"`
+}
+
+// PartyCreditProfile defines model for the PartyCreditProfile schema.
+// An individual might be evaluated for its worthiness and this evaluation might be based on a credit rating given by a credit agency.
+type PartyCreditProfile struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Name of the credit agency giving the score
+	CreditAgencyName string `json:"creditAgencyName,omitempty"`
+	// Type of the credit agency giving the score
+	CreditAgencyType string `json:"creditAgencyType,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Reference corresponding to the credit rating
+	RatingReference string `json:"ratingReference,omitempty"`
+	// A measure of a party's creditworthiness calculated on the basis of a combination of factors such as their income and credit history
+	RatingScore *int64      `json:"ratingScore,omitempty"`
+	ValidFor    *TimePeriod `json:"validFor,omitempty"`
+}
+
+// PartyOrPartyRole defines model for the PartyOrPartyRole schema.
+type PartyOrPartyRole struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType    string         `json:"@type,omitempty"`
+	Account   []AccountRef   `json:"account,omitempty"`
+	Agreement []AgreementRef `json:"agreement,omitempty"`
+	// e.g. Baron, Graf, Earl
+	AristocraticTitle string `json:"aristocraticTitle,omitempty"`
+	// Birth date
+	BirthDate *time.Time `json:"birthDate,omitempty"`
+	// Describes the characteristic of a party role.
+	Characteristic []Characteristic `json:"characteristic,omitempty"`
+	// List of means for contacting the party, e.g. mobile phone, email address
+	ContactMedium []ContactMedium `json:"contactMedium,omitempty"`
+	// Country where the individual was born
+	CountryOfBirth string          `json:"countryOfBirth,omitempty"`
+	CreditProfile  []CreditProfile `json:"creditProfile,omitempty"`
+	// List of credit profiles and scores for the party, typically received from an external credit broker
+	CreditRating []PartyCreditProfile `json:"creditRating,omitempty"`
+	// Date of death
+	DeathDate *time.Time `json:"deathDate,omitempty"`
+	// A description of the PartyRole.
+	Description string `json:"description,omitempty"`
+	// List of disabilities suffered by the individual
+	Disability   []Disability `json:"disability,omitempty"`
+	EngagedParty *PartyRef    `json:"engagedParty,omitempty"`
+	ExistsDuring *TimePeriod  `json:"existsDuring,omitempty"`
+	// List of identifiers of the Party in an external system, for example when party information is imported from a commerce system
+	ExternalReference []ExternalIdentifier `json:"externalReference,omitempty"`
+	// Contains the non-chosen or inherited name.
+	FamilyName string `json:"familyName,omitempty"`
+	// Family name prefix
+	FamilyNamePrefix string `json:"familyNamePrefix,omitempty"`
+	// A fully formatted name in one string with all of its pieces in their proper place and all of the necessary punctuation.
+	FormattedName string `json:"formattedName,omitempty"`
+	// Gender
+	Gender string `json:"gender,omitempty"`
+	// e.g..
+	Generation string `json:"generation,omitempty"`
+	// First name of the individual
+	GivenName string `json:"givenName,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// List of official identifications issued to the individual, such as passport, driving licence, social security number
+	IndividualIdentification []IndividualIdentification `json:"individualIdentification,omitempty"`
+	// If value is true, the organization is the head office
+	IsHeadOffice *bool `json:"isHeadOffice,omitempty"`
+	// If value is true, the organization is a legal entity known by a national referential.
+	IsLegalEntity *bool `json:"isLegalEntity,omitempty"`
+	// List of national languages known by the individual
+	LanguageAbility []LanguageAbility `json:"languageAbility,omitempty"`
+	// Legal name or birth name (name one has for official purposes)
+	LegalName string `json:"legalName,omitempty"`
+	// Temporary current location of the individual (may be used if the individual has approved its sharing)
+	Location string `json:"location,omitempty"`
+	// Marital status (married, divorced, widow ...)
+	MaritalStatus string `json:"maritalStatus,omitempty"`
+	// Middles name or initial
+	MiddleName string `json:"middleName,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Type of the name : Co, Inc, Ltd, etc.
+	NameType string `json:"nameType,omitempty"`
+	// Nationality
+	Nationality string `json:"nationality,omitempty"`
+	// List of organizations that are contained within this organization.
+	OrganizationChildRelationship []OrganizationChildRelationship `json:"organizationChildRelationship,omitempty"`
+	// List of official identifiers given to the organization, for example company number in the registry of companies
+	OrganizationIdentification     []OrganizationIdentification    `json:"organizationIdentification,omitempty"`
+	OrganizationParentRelationship *OrganizationParentRelationship `json:"organizationParentRelationship,omitempty"`
+	// Type of Organization (company, department...)
+	OrganizationType string `json:"organizationType,omitempty"`
+	// List of other names by which this individual is known
+	OtherName any `json:"otherName,omitempty"`
+	// List of additional characteristics that a Party can take on.
+	PartyCharacteristic []Characteristic `json:"partyCharacteristic,omitempty"`
+	// The identifier of the engaged party that is linked to the PartyRole object.
+	PartyId string `json:"partyId,omitempty"`
+	// The name of the engaged party that is linked to the PartyRole object.
+	PartyName              string                     `json:"partyName,omitempty"`
+	PartyRoleSpecification *PartyRoleSpecificationRef `json:"partyRoleSpecification,omitempty"`
+	PaymentMethod          []PaymentMethodRef         `json:"paymentMethod,omitempty"`
+	// Reference to the place where the individual was born
+	PlaceOfBirth string `json:"placeOfBirth,omitempty"`
+	// Contains the chosen name by which the individual prefers to be addressed.
+	PreferredGivenName string `json:"preferredGivenName,omitempty"`
+	// List of parties and/or party roles related to this party
+	RelatedParty []RelatedPartyOrPartyRole `json:"relatedParty,omitempty"`
+	// Role played by the engagedParty in this context.
+	Role string `json:"role,omitempty"`
+	// List of skills exhibited by the individual
+	Skill  []Skill `json:"skill,omitempty"`
+	Status string  `json:"status,omitempty"`
+	// A string providing an explanation on the value of the status lifecycle.
+	StatusReason string `json:"statusReason,omitempty"`
+	// List of tax exemptions granted to the party.
+	TaxExemptionCertificate []TaxExemptionCertificate `json:"taxExemptionCertificate,omitempty"`
+	// Useful for titles (aristocratic, social,...) Pr, Dr, Sir, ...
+	Title string `json:"title,omitempty"`
+	// Name that the organization (unit) trades under
+	TradingName string      `json:"tradingName,omitempty"`
+	ValidFor    *TimePeriod `json:"validFor,omitempty"`
+}
+
+// PartyRef defines model for the PartyRef schema.
+// A Party reference
+type PartyRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// PartyRefOrPartyRoleRef defines model for the PartyRefOrPartyRoleRef schema.
+type PartyRefOrPartyRoleRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// The identifier of the engaged party that is linked to the PartyRole object.
+	PartyId string `json:"partyId,omitempty"`
+	// The name of the engaged party that is linked to the PartyRole object.
+	PartyName string `json:"partyName,omitempty"`
+}
+
+// PartyRoleSpecificationRef defines model for the PartyRoleSpecificationRef schema.
+// Party role specification reference.
+type PartyRoleSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// PaymentMethodRef defines model for the PaymentMethodRef schema.
+// PaymentMethod reference.
+type PaymentMethodRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// PaymentRef defines model for the PaymentRef schema.
+// If an immediate payment has been done at the product order submission, the payment information are captured and stored (as a reference) in the order.
+type PaymentRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// PlaceRef defines model for the PlaceRef schema.
+// Place reference.
+type PlaceRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// PolicyRef defines model for the PolicyRef schema.
+// Reference to managed Policy object
+type PolicyRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Price defines model for the Price schema.
+// Provides all amounts (tax included, duty free, tax rate), used currency and percentage to apply for Price and Price Alteration.
+type Price struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType         string `json:"@type,omitempty"`
+	DutyFreeAmount *Money `json:"dutyFreeAmount,omitempty"`
+	// Percentage to apply for ProdOfferPriceAlteration
+	Percentage        *float64 `json:"percentage,omitempty"`
+	TaxIncludedAmount *Money   `json:"taxIncludedAmount,omitempty"`
+	// Tax rate
+	TaxRate *float64 `json:"taxRate,omitempty"`
+}
+
+// PriceAlteration defines model for the PriceAlteration schema.
+// Is an amount, usually of money, that modifies the price charged for an order item.
+type PriceAlteration struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Duration during which the alteration applies on the order item price (for instance 2 months free of charge for the recurring charge)
+	ApplicationDuration *int64 `json:"applicationDuration,omitempty"`
+	// A narrative that explains in detail the semantics of this order item price alteration
+	Description string `json:"description,omitempty"`
+	// Name of the order item price alteration
+	Name  string `json:"name,omitempty"`
+	Price *Price `json:"price,omitempty"`
+	// A category that describes the price such as recurring, one time and usage.
+	PriceType string `json:"priceType,omitempty"`
+	// Priority level for applying this alteration among all the defined alterations on the order item price
+	Priority             *int64                   `json:"priority,omitempty"`
+	ProductOfferingPrice *ProductOfferingPriceRef `json:"productOfferingPrice,omitempty"`
+	// Could be month, week...
+	RecurringChargePeriod string `json:"recurringChargePeriod,omitempty"`
+	// Could be minutes, GB...
+	UnitOfMeasure string `json:"unitOfMeasure,omitempty"`
+}
+
+// PricingLogicAlgorithm defines model for the PricingLogicAlgorithm schema.
+// The PricingLogicAlgorithm entity represents an instantiation of an interface specification to external rating function (without a modeled bahavior in SID).
+type PricingLogicAlgorithm struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of the PricingLogicAlgorithm
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name given to the PricingLogicAlgorithm
+	Name string `json:"name,omitempty"`
+	// id of corresponding PricingLogicAlgorithm specification
+	PlaSpecId string      `json:"plaSpecId,omitempty"`
+	ValidFor  *TimePeriod `json:"validFor,omitempty"`
+}
+
+// Product defines model for the Product schema.
+// A product offering procured by a customer or other interested party playing a party role.
+type Product struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// A list of agreement items related to this product
+	AgreementItem  []AgreementItemRef `json:"agreementItem,omitempty"`
+	BillingAccount *BillingAccountRef `json:"billingAccount,omitempty"`
+	// Date and time when the product was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Is the description of the product.
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, the product is a ProductBundle which is an instantiation of a BundledProductOffering.
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// If true, the product is visible by the customer.
+	IsCustomerVisible *bool `json:"isCustomerVisible,omitempty"`
+	// Name of the product.
+	Name string `json:"name,omitempty"`
+	// Is the date when the product was ordered
+	OrderDate *time.Time `json:"orderDate,omitempty"`
+	// A list of places related to this product
+	Place []RelatedPlaceRefOrValue `json:"place,omitempty"`
+	// A list of products that are part of this product bundle
+	Product []ProductRefOrValue `json:"product,omitempty"`
+	// A list of characteristics that characterize this product
+	ProductCharacteristic []Characteristic    `json:"productCharacteristic,omitempty"`
+	ProductOffering       *ProductOfferingRef `json:"productOffering,omitempty"`
+	// A list of order items related to this product
+	ProductOrderItem []RelatedOrderItem `json:"productOrderItem,omitempty"`
+	// A list of prices of this product
+	ProductPrice []ProductPrice `json:"productPrice,omitempty"`
+	// A list of relationships with other products
+	ProductRelationship []ProductRelationship `json:"productRelationship,omitempty"`
+	// Is the serial number for the product.
+	ProductSerialNumber  string                   `json:"productSerialNumber,omitempty"`
+	ProductSpecification *ProductSpecificationRef `json:"productSpecification,omitempty"`
+	// A list of terms of this product
+	ProductTerm []ProductTerm `json:"productTerm,omitempty"`
+	// A list of resources realizing this product
+	RealizingResource []ResourceRef `json:"realizingResource,omitempty"`
+	// A list of services realizing this product
+	RealizingService []ServiceRef `json:"realizingService,omitempty"`
+	// A list of parties related to this product
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// Is the date from which the product starts
+	StartDate *time.Time `json:"startDate,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	// Is the date when the product was terminated
+	TerminationDate *time.Time `json:"terminationDate,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productFields are the attributes defined in the Product schema
+var productFields = map[string]bool{
+	"@baseType":             true,
+	"@schemaLocation":       true,
+	"@type":                 true,
+	"agreementItem":         true,
+	"billingAccount":        true,
+	"creationDate":          true,
+	"description":           true,
+	"href":                  true,
+	"id":                    true,
+	"isBundle":              true,
+	"isCustomerVisible":     true,
+	"name":                  true,
+	"orderDate":             true,
+	"place":                 true,
+	"product":               true,
+	"productCharacteristic": true,
+	"productOffering":       true,
+	"productOrderItem":      true,
+	"productPrice":          true,
+	"productRelationship":   true,
+	"productSerialNumber":   true,
+	"productSpecification":  true,
+	"productTerm":           true,
+	"realizingResource":     true,
+	"realizingService":      true,
+	"relatedParty":          true,
+	"startDate":             true,
+	"status":                true,
+	"terminationDate":       true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Product) UnmarshalJSON(data []byte) error {
+	type plain Product
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Product) MarshalJSON() ([]byte, error) {
+	type plain Product
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductCatalog defines model for the ProductCatalog schema.
+// A collection of Product Offerings, intended for a specific DistributionChannel, enhanced with additional information such as SLA parameters, invoicing and shipping details
+type ProductCatalog struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Type of this Catalog, like Product, Service, Resource etc.
+	CatalogType string `json:"catalogType,omitempty"`
+	// List of root categories contained in this catalog
+	Category []CategoryRef `json:"category,omitempty"`
+	// Description of this catalog
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the catalog
+	Name string `json:"name,omitempty"`
+	// List of parties involved in this catalog
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	ValidFor     *TimePeriod                     `json:"validFor,omitempty"`
+	// Catalog version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productCatalogFields are the attributes defined in the ProductCatalog schema
+var productCatalogFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"catalogType":     true,
+	"category":        true,
+	"description":     true,
+	"href":            true,
+	"id":              true,
+	"lastUpdate":      true,
+	"lifecycleStatus": true,
+	"name":            true,
+	"relatedParty":    true,
+	"validFor":        true,
+	"version":         true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ProductCatalog) UnmarshalJSON(data []byte) error {
+	type plain ProductCatalog
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productCatalogFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ProductCatalog) MarshalJSON() ([]byte, error) {
+	type plain ProductCatalog
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductOffering defines model for the ProductOffering schema.
+// Represents entities that are orderable from the provider of the catalog, this resource includes pricing information.
+type ProductOffering struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// An agreement represents a contract or arrangement, either written or verbal and sometimes enforceable by law, such as a service level agreement or a customer price agreement.
+	Agreement []AgreementRef `json:"agreement,omitempty"`
+	// List of actions that can be executed (in context of a product order) on products instantiated from this offering
+	AllowedAction []AllowedProductAction `json:"allowedAction,omitempty"`
+	// Complements the description of an element (for instance a product) through video, pictures...
+	Attachment []AttachmentRefOrValue `json:"attachment,omitempty"`
+	// A group of ProductOfferings that can be selected for instantiation, e.g. between 2 and 7 from a list of 15 channel packs.
+	BundledGroupProductOffering []BundledGroupProductOffering `json:"bundledGroupProductOffering,omitempty"`
+	// A type of ProductOffering that belongs to a grouping of ProductOfferings made available to the market.
+	BundledProductOffering []BundledProductOffering `json:"bundledProductOffering,omitempty"`
+	// The category resource is used to group product offerings, service and resource candidates in logical containers.
+	Category []CategoryRef `json:"category,omitempty"`
+	// The channel defines the channel for selling product offerings.
+	Channel []ChannelRef `json:"channel,omitempty"`
+	// Description of the productOffering
+	Description string `json:"description,omitempty"`
+	// List of external identifieers for the offering, e.g. identifier in source catalog
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// isBundle determines whether a productOffering represents a single productOffering (false), or a bundle of productOfferings (true).
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// A flag indicating if this product offer can be sold stand-alone for sale or not.
+	IsSellable *bool `json:"isSellable,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// provides references to the corresponding market segment as target of product offerings.
+	MarketSegment []MarketSegmentRef `json:"marketSegment,omitempty"`
+	// Name of the productOffering
+	Name string `json:"name,omitempty"`
+	// Place defines the places where the products are sold or delivered.
+	Place []PlaceRef `json:"place,omitempty"`
+	// The Policy resource represents a policy/rule applied to ProductOffering.
+	Policy []PolicyRef `json:"policy,omitempty"`
+	// A use of the ProductSpecificationCharacteristicValue by a ProductOffering to which additional properties (attributes) apply or override the properties of similar properties contained in ProductSpecificationCharacteristicValue.
+	ProdSpecCharValueUse []ProductSpecificationCharacteristicValueUse `json:"prodSpecCharValueUse,omitempty"`
+	// A characteristic quality or distinctive feature of a ProductOffering.
+	ProductOfferingCharacteristic []CharacteristicSpecification `json:"productOfferingCharacteristic,omitempty"`
+	// An amount, usually of money, that is asked for or allowed when a ProductOffering is bought, rented, or leased.
+	ProductOfferingPrice []ProductOfferingPriceRefOrValue `json:"productOfferingPrice,omitempty"`
+	// A relationship between this product offering and other product offerings.
+	ProductOfferingRelationship []ProductOfferingRelationship `json:"productOfferingRelationship,omitempty"`
+	// A condition under which a ProductOffering is made available to Customers.
+	ProductOfferingTerm   []ProductOfferingTerm    `json:"productOfferingTerm,omitempty"`
+	ProductSpecification  *ProductSpecificationRef `json:"productSpecification,omitempty"`
+	ResourceCandidate     *ResourceCandidateRef    `json:"resourceCandidate,omitempty"`
+	ServiceCandidate      *ServiceCandidateRef     `json:"serviceCandidate,omitempty"`
+	ServiceLevelAgreement *SLARef                  `json:"serviceLevelAgreement,omitempty"`
+	// A string providing a complementary information on the value of the lifecycle status attribute.
+	StatusReason string      `json:"statusReason,omitempty"`
+	ValidFor     *TimePeriod `json:"validFor,omitempty"`
+	// ProductOffering version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productOfferingFields are the attributes defined in the ProductOffering schema
+var productOfferingFields = map[string]bool{
+	"@baseType":                     true,
+	"@schemaLocation":               true,
+	"@type":                         true,
+	"agreement":                     true,
+	"allowedAction":                 true,
+	"attachment":                    true,
+	"bundledGroupProductOffering":   true,
+	"bundledProductOffering":        true,
+	"category":                      true,
+	"channel":                       true,
+	"description":                   true,
+	"externalIdentifier":            true,
+	"href":                          true,
+	"id":                            true,
+	"isBundle":                      true,
+	"isSellable":                    true,
+	"lastUpdate":                    true,
+	"lifecycleStatus":               true,
+	"marketSegment":                 true,
+	"name":                          true,
+	"place":                         true,
+	"policy":                        true,
+	"prodSpecCharValueUse":          true,
+	"productOfferingCharacteristic": true,
+	"productOfferingPrice":          true,
+	"productOfferingRelationship":   true,
+	"productOfferingTerm":           true,
+	"productSpecification":          true,
+	"resourceCandidate":             true,
+	"serviceCandidate":              true,
+	"serviceLevelAgreement":         true,
+	"statusReason":                  true,
+	"validFor":                      true,
+	"version":                       true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ProductOffering) UnmarshalJSON(data []byte) error {
+	type plain ProductOffering
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productOfferingFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ProductOffering) MarshalJSON() ([]byte, error) {
+	type plain ProductOffering
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductOfferingPrice defines model for the ProductOfferingPrice schema.
+// Is based on both the basic cost to develop and produce products and the enterprises policy on revenue targets.
+type ProductOfferingPrice struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// this object represents a bundle relationship from a bundle product offering price (parent) to a simple product offering price (child).
+	BundledPopRelationship []BundledProductOfferingPriceRelationship `json:"bundledPopRelationship,omitempty"`
+	// Description of the productOfferingPrice
+	Description string `json:"description,omitempty"`
+	// List of external identifieers for the offering price, e.g. identifier in source catalog
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// A flag indicating if this ProductOfferingPrice is composite (bundle) or not
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// the last update time of this ProductOfferingPrice
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// the lifecycle status of this ProductOfferingPrice
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the productOfferingPrice
+	Name string `json:"name,omitempty"`
+	// Percentage to apply if this Product Offering Price is an Alteration (such as a Discount)
+	Percentage *float64 `json:"percentage,omitempty"`
+	// Place defines the places where the products are sold or delivered.
+	Place []PlaceRef `json:"place,omitempty"`
+	// The Policy resource represents a policy/rule applied to ProductOfferingPrice.
+	Policy []PolicyRef `json:"policy,omitempty"`
+	// Product Offering Prices related to this Product Offering Price, for example a price alteration such as allowance or discount
+	PopRelationship []ProductOfferingPriceRelationship `json:"popRelationship,omitempty"`
+	Price           *Money                             `json:"price,omitempty"`
+	// A category that describes the price, such as recurring, discount, allowance, penalty, and so forth.
+	PriceType string `json:"priceType,omitempty"`
+	// The PricingLogicAlgorithm entity represents an instantiation of an interface specification to external rating function (without a modeled behavior in SID).
+	PricingLogicAlgorithm []PricingLogicAlgorithm `json:"pricingLogicAlgorithm,omitempty"`
+	// A use of the ProductSpecificationCharacteristicValue by a ProductOfferingPrice to which additional properties (attributes) apply or override the properties of similar properties contained in ProductSpecificationCharacteristicValue.
+	ProdSpecCharValueUse []ProductSpecificationCharacteristicValueUse `json:"prodSpecCharValueUse,omitempty"`
+	// A list of conditions under which a ProductOfferingPrice is made available to Customers.
+	ProductOfferingTerm []ProductOfferingTerm `json:"productOfferingTerm,omitempty"`
+	// the period of the recurring charge: 1, 2, ...
+	RecurringChargePeriodLength *int64 `json:"recurringChargePeriodLength,omitempty"`
+	// The period to repeat the application of the price Could be month, week...
+	RecurringChargePeriodType string `json:"recurringChargePeriodType,omitempty"`
+	// An amount of money levied on the price of a Product by a legislative body.
+	Tax           []TaxItem   `json:"tax,omitempty"`
+	UnitOfMeasure *Quantity   `json:"unitOfMeasure,omitempty"`
+	ValidFor      *TimePeriod `json:"validFor,omitempty"`
+	// ProductOfferingPrice version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productOfferingPriceFields are the attributes defined in the ProductOfferingPrice schema
+var productOfferingPriceFields = map[string]bool{
+	"@baseType":                   true,
+	"@schemaLocation":             true,
+	"@type":                       true,
+	"bundledPopRelationship":      true,
+	"description":                 true,
+	"externalIdentifier":          true,
+	"href":                        true,
+	"id":                          true,
+	"isBundle":                    true,
+	"lastUpdate":                  true,
+	"lifecycleStatus":             true,
+	"name":                        true,
+	"percentage":                  true,
+	"place":                       true,
+	"policy":                      true,
+	"popRelationship":             true,
+	"price":                       true,
+	"priceType":                   true,
+	"pricingLogicAlgorithm":       true,
+	"prodSpecCharValueUse":        true,
+	"productOfferingTerm":         true,
+	"recurringChargePeriodLength": true,
+	"recurringChargePeriodType":   true,
+	"tax":                         true,
+	"unitOfMeasure":               true,
+	"validFor":                    true,
+	"version":                     true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ProductOfferingPrice) UnmarshalJSON(data []byte) error {
+	type plain ProductOfferingPrice
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productOfferingPriceFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ProductOfferingPrice) MarshalJSON() ([]byte, error) {
+	type plain ProductOfferingPrice
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductOfferingPriceRef defines model for the ProductOfferingPriceRef schema.
+// ProductPriceOffering reference.
+type ProductOfferingPriceRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the product offering price
+	Version string `json:"version,omitempty"`
+}
+
+// ProductOfferingPriceRefOrValue defines model for the ProductOfferingPriceRefOrValue schema.
+// The polymorphic attributes @type, @schemaLocation & @referredType are related to the ProductOfferingPrice entity and not the ProductOfferingPriceRefOrValue class itself
+type ProductOfferingPriceRefOrValue struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// this object represents a bundle relationship from a bundle product offering price (parent) to a simple product offering price (child).
+	BundledPopRelationship []BundledProductOfferingPriceRelationship `json:"bundledPopRelationship,omitempty"`
+	// Description of the productOfferingPrice
+	Description string `json:"description,omitempty"`
+	// List of external identifieers for the offering price, e.g. identifier in source catalog
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// A flag indicating if this ProductOfferingPrice is composite (bundle) or not
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// the last update time of this ProductOfferingPrice
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// the lifecycle status of this ProductOfferingPrice
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the productOfferingPrice
+	Name string `json:"name,omitempty"`
+	// Percentage to apply if this Product Offering Price is an Alteration (such as a Discount)
+	Percentage *float64 `json:"percentage,omitempty"`
+	// Place defines the places where the products are sold or delivered.
+	Place []PlaceRef `json:"place,omitempty"`
+	// The Policy resource represents a policy/rule applied to ProductOfferingPrice.
+	Policy []PolicyRef `json:"policy,omitempty"`
+	// Product Offering Prices related to this Product Offering Price, for example a price alteration such as allowance or discount
+	PopRelationship []ProductOfferingPriceRelationship `json:"popRelationship,omitempty"`
+	Price           *Money                             `json:"price,omitempty"`
+	// A category that describes the price, such as recurring, discount, allowance, penalty, and so forth.
+	PriceType string `json:"priceType,omitempty"`
+	// The PricingLogicAlgorithm entity represents an instantiation of an interface specification to external rating function (without a modeled behavior in SID).
+	PricingLogicAlgorithm []PricingLogicAlgorithm `json:"pricingLogicAlgorithm,omitempty"`
+	// A use of the ProductSpecificationCharacteristicValue by a ProductOfferingPrice to which additional properties (attributes) apply or override the properties of similar properties contained in ProductSpecificationCharacteristicValue.
+	ProdSpecCharValueUse []ProductSpecificationCharacteristicValueUse `json:"prodSpecCharValueUse,omitempty"`
+	// A list of conditions under which a ProductOfferingPrice is made available to Customers.
+	ProductOfferingTerm []ProductOfferingTerm `json:"productOfferingTerm,omitempty"`
+	// the period of the recurring charge: 1, 2, ...
+	RecurringChargePeriodLength *int64 `json:"recurringChargePeriodLength,omitempty"`
+	// The period to repeat the application of the price Could be month, week...
+	RecurringChargePeriodType string `json:"recurringChargePeriodType,omitempty"`
+	// An amount of money levied on the price of a Product by a legislative body.
+	Tax           []TaxItem   `json:"tax,omitempty"`
+	UnitOfMeasure *Quantity   `json:"unitOfMeasure,omitempty"`
+	ValidFor      *TimePeriod `json:"validFor,omitempty"`
+	// ProductOfferingPrice version
+	Version string `json:"version,omitempty"`
+}
+
+// ProductOfferingPriceRelationship defines model for the ProductOfferingPriceRelationship schema.
+// Describes a non-composite relationship between product offering prices.
+type ProductOfferingPriceRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// type of the relationship, for example override, discount, etc.
+	RelationshipType string `json:"relationshipType,omitempty"`
+	// The association role for the source product offering price
+	Role string `json:"role,omitempty"`
+	// Version of the referred product offering price.
+	Version string `json:"version,omitempty"`
+}
+
+// ProductOfferingRef defines model for the ProductOfferingRef schema.
+// ProductOffering reference.
+type ProductOfferingRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the product offering
+	Version string `json:"version,omitempty"`
+}
+
+// ProductOfferingRelationship defines model for the ProductOfferingRelationship schema.
+// A relationship between two product Offerings.
+type ProductOfferingRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Type of relationship between product offerings such as requires, exchangableTo, optionalFor
+	RelationshipType string `json:"relationshipType,omitempty"`
+	// The association role for the source product offering
+	Role     string      `json:"role,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+	// Version of the referred product offering.
+	Version string `json:"version,omitempty"`
+}
+
+// ProductOfferingTerm defines model for the ProductOfferingTerm schema.
+// A condition under which a ProductOffering is made available to Customers.
+type ProductOfferingTerm struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of the productOfferingTerm
+	Description string    `json:"description,omitempty"`
+	Duration    *Duration `json:"duration,omitempty"`
+	// Name of the productOfferingTerm
+	Name     string      `json:"name,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// ProductOrder defines model for the ProductOrder schema.
+// A Product Order is a type of order which can be used to place an order between a customer and a service provider or between a service provider and a partner and vice versa.
+type ProductOrder struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// A reference to an agreement defined in the context of the product order
+	Agreement      []AgreementRef     `json:"agreement,omitempty"`
+	BillingAccount *BillingAccountRef `json:"billingAccount,omitempty"`
+	// Date when the order is cancelled.
+	CancellationDate *time.Time `json:"cancellationDate,omitempty"`
+	// Reason why the order is cancelled.
+	CancellationReason string `json:"cancellationReason,omitempty"`
+	// Used to categorize the order from a business perspective that can be useful for the OM system (e.g. "enterprise", "residential", ...)
+	Category string `json:"category,omitempty"`
+	// Channels through which the order was placed
+	Channel []RelatedChannel `json:"channel,omitempty"`
+	// Date when the order was completed
+	CompletionDate *time.Time `json:"completionDate,omitempty"`
+	// Date when the order was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Description of the product order
+	Description string `json:"description,omitempty"`
+	// Expected delivery date amended by the provider
+	ExpectedCompletionDate *time.Time `json:"expectedCompletionDate,omitempty"`
+	// A list of identifiers that are assigned to the order by the requester
+	ExternalId []ExternalIdentifier `json:"externalId,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// A list of notes made on the order
+	Note []Note `json:"note,omitempty"`
+	// Contact attached to the order to send back information regarding this order
+	NotificationContact string `json:"notificationContact,omitempty"`
+	// Total price of the order
+	OrderTotalPrice []OrderPrice `json:"orderTotalPrice,omitempty"`
+	// Payments done at the order submission
+	Payment []PaymentRef `json:"payment,omitempty"`
+	// A way that can be used by consumers to prioritize orders in OM system (from 0 to 4 : 0 is the highest priority, and 4 the lowest)
+	Priority string `json:"priority,omitempty"`
+	// A list of order items of the product order
+	ProductOrderItem []ProductOrderItem `json:"productOrderItem,omitempty"`
+	// A list of parties which are involved in this order and the role they are playing
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// Requested delivery date from the requester perspective
+	RequestedCompletionDate *time.Time `json:"requestedCompletionDate,omitempty"`
+	// Order fulfillment start date wished by the requester.
+	RequestedStartDate *time.Time `json:"requestedStartDate,omitempty"`
+	State              string     `json:"state,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productOrderFields are the attributes defined in the ProductOrder schema
+var productOrderFields = map[string]bool{
+	"@baseType":               true,
+	"@schemaLocation":         true,
+	"@type":                   true,
+	"agreement":               true,
+	"billingAccount":          true,
+	"cancellationDate":        true,
+	"cancellationReason":      true,
+	"category":                true,
+	"channel":                 true,
+	"completionDate":          true,
+	"creationDate":            true,
+	"description":             true,
+	"expectedCompletionDate":  true,
+	"externalId":              true,
+	"href":                    true,
+	"id":                      true,
+	"note":                    true,
+	"notificationContact":     true,
+	"orderTotalPrice":         true,
+	"payment":                 true,
+	"priority":                true,
+	"productOrderItem":        true,
+	"relatedParty":            true,
+	"requestedCompletionDate": true,
+	"requestedStartDate":      true,
+	"state":                   true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ProductOrder) UnmarshalJSON(data []byte) error {
+	type plain ProductOrder
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productOrderFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ProductOrder) MarshalJSON() ([]byte, error) {
+	type plain ProductOrder
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductOrderItem defines model for the ProductOrderItem schema.
+// An identified part of the order.
+type ProductOrderItem struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType         string             `json:"@type,omitempty"`
+	Action         string             `json:"action,omitempty"`
+	BillingAccount *BillingAccountRef `json:"billingAccount,omitempty"`
+	// Identifier of the individual line item
+	ID string `json:"id,omitempty"`
+	// Price of the order item
+	ItemPrice []OrderPrice `json:"itemPrice,omitempty"`
+	// Total price of the order item
+	ItemTotalPrice []OrderPrice `json:"itemTotalPrice,omitempty"`
+	// Free-text notes of the order item
+	Note []Note `json:"note,omitempty"`
+	// Payments of the order item
+	Payment         []PaymentRef        `json:"payment,omitempty"`
+	Product         *ProductRefOrValue  `json:"product,omitempty"`
+	ProductOffering *ProductOfferingRef `json:"productOffering,omitempty"`
+	// Order items contained in this order item
+	ProductOrderItem []ProductOrderItem `json:"productOrderItem,omitempty"`
+	// Relationships with other order items of the same order
+	ProductOrderItemRelationship []OrderItemRelationship `json:"productOrderItemRelationship,omitempty"`
+	// Quantity ordered
+	Quantity *int64 `json:"quantity,omitempty"`
+	State    string `json:"state,omitempty"`
+}
+
+// ProductOrderRef defines model for the ProductOrderRef schema.
+// It's a productOrder that has been executed previously.
+type ProductOrderRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// ProductPrice defines model for the ProductPrice schema.
+// Description of price and discount awarded
+type ProductPrice struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of price and discount awarded
+	Description string `json:"description,omitempty"`
+	// Name of the productPrice
+	Name  string `json:"name,omitempty"`
+	Price *Price `json:"price,omitempty"`
+	// A list of price alterations applied to the price
+	PriceAlteration []PriceAlteration `json:"priceAlteration,omitempty"`
+	// Indicate if the price is for recurrent or no-recurrent charge
+	PriceType            string                   `json:"priceType,omitempty"`
+	ProductOfferingPrice *ProductOfferingPriceRef `json:"productOfferingPrice,omitempty"`
+	// Used for recurring charge to indicate period (month, week, etc..)
+	RecurringChargePeriod string `json:"recurringChargePeriod,omitempty"`
+	// Unit of Measure if price depending on it (Gb, SMS volume, etc..)
+	UnitOfMeasure string `json:"unitOfMeasure,omitempty"`
+}
+
+// ProductRef defines model for the ProductRef schema.
+// Reference to a product instance in the inventory.
+type ProductRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// ProductRefOrValue defines model for the ProductRefOrValue schema.
+// The polymorphic attributes @type, @schemaLocation & @referredType are related to the Product entity and not the ProductRefOrValue class itself
+type ProductRefOrValue struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// A list of agreement items related to this product
+	AgreementItem  []AgreementItemRef `json:"agreementItem,omitempty"`
+	BillingAccount *BillingAccountRef `json:"billingAccount,omitempty"`
+	// Date and time when the product was created
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	// Is the description of the product.
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, the product is a ProductBundle which is an instantiation of a BundledProductOffering.
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// If true, the product is visible by the customer.
+	IsCustomerVisible *bool `json:"isCustomerVisible,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Is the date when the product was ordered
+	OrderDate *time.Time `json:"orderDate,omitempty"`
+	// A list of places related to this product
+	Place []RelatedPlaceRefOrValue `json:"place,omitempty"`
+	// A list of products that are part of this product bundle
+	Product []ProductRefOrValue `json:"product,omitempty"`
+	// A list of characteristics that characterize this product
+	ProductCharacteristic []Characteristic    `json:"productCharacteristic,omitempty"`
+	ProductOffering       *ProductOfferingRef `json:"productOffering,omitempty"`
+	// A list of order items related to this product
+	ProductOrderItem []RelatedOrderItem `json:"productOrderItem,omitempty"`
+	// A list of prices of this product
+	ProductPrice []ProductPrice `json:"productPrice,omitempty"`
+	// A list of relationships with other products
+	ProductRelationship []ProductRelationship `json:"productRelationship,omitempty"`
+	// Is the serial number for the product.
+	ProductSerialNumber  string                   `json:"productSerialNumber,omitempty"`
+	ProductSpecification *ProductSpecificationRef `json:"productSpecification,omitempty"`
+	// A list of terms of this product
+	ProductTerm []ProductTerm `json:"productTerm,omitempty"`
+	// A list of resources realizing this product
+	RealizingResource []ResourceRef `json:"realizingResource,omitempty"`
+	// A list of services realizing this product
+	RealizingService []ServiceRef `json:"realizingService,omitempty"`
+	// A list of parties related to this product
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// Is the date from which the product starts
+	StartDate *time.Time `json:"startDate,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	// Is the date when the product was terminated
+	TerminationDate *time.Time `json:"terminationDate,omitempty"`
+}
+
+// ProductRelationship defines model for the ProductRelationship schema.
+// Used to describe relationship between product.
+type ProductRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType  string             `json:"@type,omitempty"`
+	Product *ProductRefOrValue `json:"product,omitempty"`
+	// Relationship type as relies on, bundles, etc...
+	RelationshipType string `json:"relationshipType,omitempty"`
+}
+
+// ProductSpecification defines model for the ProductSpecification schema.
+// Is a detailed description of a tangible or intangible object made available externally in the form of a ProductOffering to customers or other parties playing a party role.
+type ProductSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Complements the description of an element (for instance a product) through video, pictures...
+	Attachment []AttachmentRefOrValue `json:"attachment,omitempty"`
+	// The manufacturer or trademark of the specification
+	Brand string `json:"brand,omitempty"`
+	// A type of ProductSpecification that belongs to a grouping of ProductSpecifications made available to the market.
+	BundledProductSpecification []BundledProductSpecification `json:"bundledProductSpecification,omitempty"`
+	// The category resource is used to group product specifications in logical containers.
+	Category []CategoryRef `json:"category,omitempty"`
+	// A narrative that explains in detail what the product specification is
+	Description string `json:"description,omitempty"`
+	// List of external identifieers for the specification, e.g. identifier in source catalog
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID                  string                  `json:"id,omitempty"`
+	IntentSpecification *IntentSpecificationRef `json:"intentSpecification,omitempty"`
+	// isBundle determines whether a productSpecification represents a single productSpecification (false), or a bundle of productSpecification (true).
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the product specification
+	Name string `json:"name,omitempty"`
+	// The Policy resource represents a policy/rule applied to ProductSpecification.
+	Policy []PolicyRef `json:"policy,omitempty"`
+	// An identification number assigned to uniquely identity the specification
+	ProductNumber string `json:"productNumber,omitempty"`
+	// A characteristic quality or distinctive feature of a ProductSpecification.
+	ProductSpecCharacteristic []CharacteristicSpecification `json:"productSpecCharacteristic,omitempty"`
+	// A migration, substitution, dependency or exclusivity relationship between/among product specifications.
+	ProductSpecificationRelationship []ProductSpecificationRelationship `json:"productSpecificationRelationship,omitempty"`
+	// A related party defines party or party role linked to a specific entity.
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// The ResourceSpecification is required to realize a ProductSpecification.
+	ResourceSpecification []ResourceSpecificationRef `json:"resourceSpecification,omitempty"`
+	// ServiceSpecification(s) required to realize a ProductSpecification.
+	ServiceSpecification []ServiceSpecificationRef `json:"serviceSpecification,omitempty"`
+	TargetProductSchema  *TargetProductSchema      `json:"targetProductSchema,omitempty"`
+	ValidFor             *TimePeriod               `json:"validFor,omitempty"`
+	// Product specification version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// productSpecificationFields are the attributes defined in the ProductSpecification schema
+var productSpecificationFields = map[string]bool{
+	"@baseType":                        true,
+	"@schemaLocation":                  true,
+	"@type":                            true,
+	"attachment":                       true,
+	"brand":                            true,
+	"bundledProductSpecification":      true,
+	"category":                         true,
+	"description":                      true,
+	"externalIdentifier":               true,
+	"href":                             true,
+	"id":                               true,
+	"intentSpecification":              true,
+	"isBundle":                         true,
+	"lastUpdate":                       true,
+	"lifecycleStatus":                  true,
+	"name":                             true,
+	"policy":                           true,
+	"productNumber":                    true,
+	"productSpecCharacteristic":        true,
+	"productSpecificationRelationship": true,
+	"relatedParty":                     true,
+	"resourceSpecification":            true,
+	"serviceSpecification":             true,
+	"targetProductSchema":              true,
+	"validFor":                         true,
+	"version":                          true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ProductSpecification) UnmarshalJSON(data []byte) error {
+	type plain ProductSpecification
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, productSpecificationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ProductSpecification) MarshalJSON() ([]byte, error) {
+	type plain ProductSpecification
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ProductSpecificationCharacteristicValueUse defines model for the ProductSpecificationCharacteristicValueUse schema.
+// A use of the ProductSpecificationCharacteristicValue by a ProductOffering to which additional properties (attributes) apply or override the properties of similar properties contained in ProductSpecificationCharacteristicValue.
+type ProductSpecificationCharacteristicValueUse struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// A narrative that explains in detail what the productSpecificationCharacteristic is
+	Description string `json:"description,omitempty"`
+	// Unique ID for the characteristic
+	ID string `json:"id,omitempty"`
+	// The maximum number of instances a CharacteristicValue can take on.
+	MaxCardinality *int64 `json:"maxCardinality,omitempty"`
+	// The minimum number of instances a CharacteristicValue can take on.
+	MinCardinality *int64 `json:"minCardinality,omitempty"`
+	// Name of the associated productSpecificationCharacteristic
+	Name string `json:"name,omitempty"`
+	// A number or text that can be assigned to a ProductSpecificationCharacteristic.
+	ProductSpecCharacteristicValue []CharacteristicValueSpecification `json:"productSpecCharacteristicValue,omitempty"`
+	ProductSpecification           *ProductSpecificationRef           `json:"productSpecification,omitempty"`
+	ValidFor                       *TimePeriod                        `json:"validFor,omitempty"`
+	// A kind of value that the characteristic can take on, such as numeric, text and so forth
+	ValueType string `json:"valueType,omitempty"`
+}
+
+// ProductSpecificationRef defines model for the ProductSpecificationRef schema.
+// ProductSpecification reference.
+type ProductSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name                string               `json:"name,omitempty"`
+	TargetProductSchema *TargetProductSchema `json:"targetProductSchema,omitempty"`
+	// Version of the product specification
+	Version string `json:"version,omitempty"`
+}
+
+// ProductSpecificationRelationship defines model for the ProductSpecificationRelationship schema.
+// A migration, substitution, dependency or exclusivity relationship between/among product specifications.
+type ProductSpecificationRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// A characteristic that refines the relationship.
+	Characteristic []CharacteristicSpecification `json:"characteristic,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// type of the relationship, for example override, discount, etc.
+	RelationshipType string      `json:"relationshipType,omitempty"`
+	ValidFor         *TimePeriod `json:"validFor,omitempty"`
+	// Version of the referred product specification.
+	Version string `json:"version,omitempty"`
+}
+
+// ProductTerm defines model for the ProductTerm schema.
+// Description of a productTerm linked to this product
+type ProductTerm struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of the productTerm
+	Description string    `json:"description,omitempty"`
+	Duration    *Quantity `json:"duration,omitempty"`
+	// Name of the productTerm
+	Name     string      `json:"name,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// Quantity defines model for the Quantity schema.
+// An amount in a given unit
+type Quantity struct {
+	// Numeric value in a given unit
+	Amount *float64 `json:"amount,omitempty"`
+	// Unit
+	Units string `json:"units,omitempty"`
+}
+
+// RatedProductUsage defines model for the RatedProductUsage schema.
+// An occurrence of employing a product for its intended purpose with all rating details
+type RatedProductUsage struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType                       string `json:"@type,omitempty"`
+	BucketValueConvertedInAmount *Money `json:"bucketValueConvertedInAmount,omitempty"`
+	// Currency code
+	CurrencyCode string `json:"currencyCode,omitempty"`
+	// Boolean indicating if usage have been billed or not
+	IsBilled *bool `json:"isBilled,omitempty"`
+	// Indicates if the rated amount is exempt of tax
+	IsTaxExempt *bool `json:"isTaxExempt,omitempty"`
+	// Type of tariff applied
+	OfferTariffType string      `json:"offerTariffType,omitempty"`
+	ProductRef      *ProductRef `json:"productRef,omitempty"`
+	// Type of amount
+	RatingAmountType string `json:"ratingAmountType,omitempty"`
+	// Date of usage rating
+	RatingDate              *time.Time `json:"ratingDate,omitempty"`
+	TaxExcludedRatingAmount *Money     `json:"taxExcludedRatingAmount,omitempty"`
+	TaxIncludedRatingAmount *Money     `json:"taxIncludedRatingAmount,omitempty"`
+	// Tax rate
+	TaxRate *float64 `json:"taxRate,omitempty"`
+	// Tag value: [usage]: the usage is always rated outside a usage bundle, [included usage]: the usage is rated inside a usage bundle, [non included usage]: the usage bundle is exceeded
+	UsageRatingTag string `json:"usageRatingTag,omitempty"`
+}
+
+// RelatedChannel defines model for the RelatedChannel schema.
+// Related channel to another entity.
+type RelatedChannel struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType  string      `json:"@type,omitempty"`
+	Channel *ChannelRef `json:"channel,omitempty"`
+	// Role of the channel for the related entity
+	Role string `json:"role,omitempty"`
+}
+
+// RelatedOrderItem defines model for the RelatedOrderItem schema.
+// It's a Order item that has been executed previously.
+type RelatedOrderItem struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Reference of the related entity.
+	OrderHref string `json:"orderHref,omitempty"`
+	// Unique identifier of a related Order.
+	OrderId         string `json:"orderId,omitempty"`
+	OrderItemAction string `json:"orderItemAction,omitempty"`
+	// Id of an item of a product order
+	OrderItemId string `json:"orderItemId,omitempty"`
+	// role of the product order item for this product
+	Role string `json:"role,omitempty"`
+}
+
+// RelatedPartyOrPartyRole defines model for the RelatedPartyOrPartyRole schema.
+// RelatedParty reference.
+type RelatedPartyOrPartyRole struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType           string            `json:"@type,omitempty"`
+	PartyOrPartyRole *PartyOrPartyRole `json:"partyOrPartyRole,omitempty"`
+	// Role played by the related party or party role in the context of the specific entity it is linked to.
+	Role string `json:"role,omitempty"`
+}
+
+// RelatedPartyRefOrPartyRoleRef defines model for the RelatedPartyRefOrPartyRoleRef schema.
+// RelatedParty reference.
+type RelatedPartyRefOrPartyRoleRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType           string                  `json:"@type,omitempty"`
+	PartyOrPartyRole *PartyRefOrPartyRoleRef `json:"partyOrPartyRole,omitempty"`
+	// Role played by the related party or party role in the context of the specific entity it is linked to.
+	Role string `json:"role,omitempty"`
+}
+
+// RelatedPlaceRefOrValue defines model for the RelatedPlaceRefOrValue schema.
+// Entity reference.
+type RelatedPlaceRefOrValue struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string    `json:"@type,omitempty"`
+	Place  *PlaceRef `json:"place,omitempty"`
+	// Role of the place for the related entity
+	Role string `json:"role,omitempty"`
+}
+
+// ResourceCandidate defines model for the ResourceCandidate schema.
+// ResourceCandidate is an entity that makes a ResourceSpecification available to a catalog.
+type ResourceCandidate struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of categories for this candidate
+	Category []ResourceCategoryRef `json:"category,omitempty"`
+	// Description of this REST resource
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update of this REST resource
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status of the candidate.
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name given to this REST resource
+	Name                  string                    `json:"name,omitempty"`
+	ResourceSpecification *ResourceSpecificationRef `json:"resourceSpecification,omitempty"`
+	ValidFor              *TimePeriod               `json:"validFor,omitempty"`
+	// the version of resource candidate
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// resourceCandidateFields are the attributes defined in the ResourceCandidate schema
+var resourceCandidateFields = map[string]bool{
+	"@baseType":             true,
+	"@schemaLocation":       true,
+	"@type":                 true,
+	"category":              true,
+	"description":           true,
+	"href":                  true,
+	"id":                    true,
+	"lastUpdate":            true,
+	"lifecycleStatus":       true,
+	"name":                  true,
+	"resourceSpecification": true,
+	"validFor":              true,
+	"version":               true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ResourceCandidate) UnmarshalJSON(data []byte) error {
+	type plain ResourceCandidate
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, resourceCandidateFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ResourceCandidate) MarshalJSON() ([]byte, error) {
+	type plain ResourceCandidate
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ResourceCandidateRef defines model for the ResourceCandidateRef schema.
+// ResourceCandidate is an entity that makes a resource specification available to a catalog.
+type ResourceCandidateRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// the version of resource candidate
+	Version string `json:"version,omitempty"`
+}
+
+// ResourceCatalog defines model for the ResourceCatalog schema.
+// The root entity for resource catalog management.
+type ResourceCatalog struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of resource categories associated with this catalog
+	Category []ResourceCategoryRef `json:"category,omitempty"`
+	// Description of this catalog
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the catalog
+	Name string `json:"name,omitempty"`
+	// List of parties involved in this catalog
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	ValidFor     *TimePeriod                     `json:"validFor,omitempty"`
+	// Catalog version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// resourceCatalogFields are the attributes defined in the ResourceCatalog schema
+var resourceCatalogFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"category":        true,
+	"description":     true,
+	"href":            true,
+	"id":              true,
+	"lastUpdate":      true,
+	"lifecycleStatus": true,
+	"name":            true,
+	"relatedParty":    true,
+	"validFor":        true,
+	"version":         true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ResourceCatalog) UnmarshalJSON(data []byte) error {
+	type plain ResourceCatalog
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, resourceCatalogFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ResourceCatalog) MarshalJSON() ([]byte, error) {
+	type plain ResourceCatalog
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ResourceCategory defines model for the ResourceCategory schema.
+// The (abstract) root resource category of the resource catalog.
+type ResourceCategory struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of child categories in the tree for in this category
+	Category []ResourceCategoryRef `json:"category,omitempty"`
+	// Description of the category
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, this Boolean indicates that the category is a root of categories
+	IsRoot *bool `json:"isRoot,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the category
+	Name string `json:"name,omitempty"`
+	// Unique identifier of the parent category
+	ParentId string `json:"parentId,omitempty"`
+	// List of resource candidates associated with this category
+	ResourceCandidate []ResourceCandidateRef `json:"resourceCandidate,omitempty"`
+	ValidFor          *TimePeriod            `json:"validFor,omitempty"`
+	// Category version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// resourceCategoryFields are the attributes defined in the ResourceCategory schema
+var resourceCategoryFields = map[string]bool{
+	"@baseType":         true,
+	"@schemaLocation":   true,
+	"@type":             true,
+	"category":          true,
+	"description":       true,
+	"href":              true,
+	"id":                true,
+	"isRoot":            true,
+	"lastUpdate":        true,
+	"lifecycleStatus":   true,
+	"name":              true,
+	"parentId":          true,
+	"resourceCandidate": true,
+	"validFor":          true,
+	"version":           true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ResourceCategory) UnmarshalJSON(data []byte) error {
+	type plain ResourceCategory
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, resourceCategoryFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ResourceCategory) MarshalJSON() ([]byte, error) {
+	type plain ResourceCategory
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ResourceCategoryRef defines model for the ResourceCategoryRef schema.
+// Reference to a resource category in the catalog.
+type ResourceCategoryRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the category
+	Version string `json:"version,omitempty"`
+}
+
+// ResourceRef defines model for the ResourceRef schema.
+// Resource reference, for when Resource is used by other entities.
+type ResourceRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// ResourceSpecification defines model for the ResourceSpecification schema.
+// Resources are physical or non-physical components (or some combination of these) within an enterprise's infrastructure or inventory.
+type ResourceSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Attachments such as pictures or documents describing the resource
+	Attachment []AttachmentRefOrValue `json:"attachment,omitempty"`
+	// Category of the target resource like NetworkConnectivity, PhysicalLinks, Generic, L2Network and so on.
+	Category string `json:"category,omitempty"`
+	// Description of the specification
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// A flag indicates that if this resource specification is a bundled specification (true) or single (false).
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// Date and time of the last update of the specification
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status of the resource specification
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name given to the specification
+	Name string `json:"name,omitempty"`
+	// Parties who manage or otherwise have an interest in this specification
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// List of characteristics that the resource can take
+	ResourceSpecCharacteristic []CharacteristicSpecification `json:"resourceSpecCharacteristic,omitempty"`
+	// Relationships to other resource specifications
+	ResourceSpecRelationship []ResourceSpecificationRelationship `json:"resourceSpecRelationship,omitempty"`
+	ValidFor                 *TimePeriod                         `json:"validFor,omitempty"`
+	// Resource specification version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// resourceSpecificationFields are the attributes defined in the ResourceSpecification schema
+var resourceSpecificationFields = map[string]bool{
+	"@baseType":                  true,
+	"@schemaLocation":            true,
+	"@type":                      true,
+	"attachment":                 true,
+	"category":                   true,
+	"description":                true,
+	"href":                       true,
+	"id":                         true,
+	"isBundle":                   true,
+	"lastUpdate":                 true,
+	"lifecycleStatus":            true,
+	"name":                       true,
+	"relatedParty":               true,
+	"resourceSpecCharacteristic": true,
+	"resourceSpecRelationship":   true,
+	"validFor":                   true,
+	"version":                    true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ResourceSpecification) UnmarshalJSON(data []byte) error {
+	type plain ResourceSpecification
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, resourceSpecificationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ResourceSpecification) MarshalJSON() ([]byte, error) {
+	type plain ResourceSpecification
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ResourceSpecificationRef defines model for the ResourceSpecificationRef schema.
+// Resources are physical or non-physical components (or some combination of these) within an enterprise's infrastructure or inventory.
+type ResourceSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Resource Specification version
+	Version string `json:"version,omitempty"`
+}
+
+// ResourceSpecificationRelationship defines model for the ResourceSpecificationRelationship schema.
+// A migration, substitution, dependency or exclusivity relationship between/among resource specifications.
+type ResourceSpecificationRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference to the target specification
+	Href string `json:"href,omitempty"`
+	// Unique identifier of the target resource specification
+	ID string `json:"id,omitempty"`
+	// Name of the target specification
+	Name string `json:"name,omitempty"`
+	// Type of relationship such as migration, substitution, dependency, exclusivity
+	RelationshipType string `json:"relationshipType,omitempty"`
+	// The association role for this resource specification
+	Role     string      `json:"role,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// SLARef defines model for the SLARef schema.
+// ServiceLevelAgreement reference: A service level agreement (SLA) is a type of agreement that represents a formal negotiated agreement between two parties designed to create a common understanding about products, services, priorities, responsibilities, and so forth.
+type SLARef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceCandidate defines model for the ServiceCandidate schema.
+// ServiceCandidate is an entity that makes a ServiceSpecification available to a catalog.
+type ServiceCandidate struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of categories for this candidate
+	Category []ServiceCategoryRef `json:"category,omitempty"`
+	// Description of this REST resource
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update of this REST resource
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status of the candidate.
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name given to this REST resource
+	Name                 string                   `json:"name,omitempty"`
+	ServiceSpecification *ServiceSpecificationRef `json:"serviceSpecification,omitempty"`
+	ValidFor             *TimePeriod              `json:"validFor,omitempty"`
+	// the version of service candidate
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// serviceCandidateFields are the attributes defined in the ServiceCandidate schema
+var serviceCandidateFields = map[string]bool{
+	"@baseType":            true,
+	"@schemaLocation":      true,
+	"@type":                true,
+	"category":             true,
+	"description":          true,
+	"href":                 true,
+	"id":                   true,
+	"lastUpdate":           true,
+	"lifecycleStatus":      true,
+	"name":                 true,
+	"serviceSpecification": true,
+	"validFor":             true,
+	"version":              true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ServiceCandidate) UnmarshalJSON(data []byte) error {
+	type plain ServiceCandidate
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, serviceCandidateFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ServiceCandidate) MarshalJSON() ([]byte, error) {
+	type plain ServiceCandidate
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ServiceCandidateRef defines model for the ServiceCandidateRef schema.
+// ServiceCandidate reference.
+type ServiceCandidateRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the service candidate
+	Version string `json:"version,omitempty"`
+}
+
+// ServiceCatalog defines model for the ServiceCatalog schema.
+// The root entity for service catalog management.
+type ServiceCatalog struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of service categories associated with this catalog
+	Category []ServiceCategoryRef `json:"category,omitempty"`
+	// Description of this catalog
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the catalog
+	Name string `json:"name,omitempty"`
+	// List of parties involved in this catalog
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	ValidFor     *TimePeriod                     `json:"validFor,omitempty"`
+	// Catalog version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// serviceCatalogFields are the attributes defined in the ServiceCatalog schema
+var serviceCatalogFields = map[string]bool{
+	"@baseType":       true,
+	"@schemaLocation": true,
+	"@type":           true,
+	"category":        true,
+	"description":     true,
+	"href":            true,
+	"id":              true,
+	"lastUpdate":      true,
+	"lifecycleStatus": true,
+	"name":            true,
+	"relatedParty":    true,
+	"validFor":        true,
+	"version":         true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ServiceCatalog) UnmarshalJSON(data []byte) error {
+	type plain ServiceCatalog
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, serviceCatalogFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ServiceCatalog) MarshalJSON() ([]byte, error) {
+	type plain ServiceCatalog
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ServiceCategory defines model for the ServiceCategory schema.
+// The (abstract) root service category of the service catalog.
+type ServiceCategory struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// List of child categories in the tree for in this category
+	Category []ServiceCategoryRef `json:"category,omitempty"`
+	// Description of the category
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// If true, this Boolean indicates that the category is a root of categories
+	IsRoot *bool `json:"isRoot,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the category
+	Name string `json:"name,omitempty"`
+	// Unique identifier of the parent category
+	ParentId string `json:"parentId,omitempty"`
+	// List of service candidates associated with this category
+	ServiceCandidate []ServiceCandidateRef `json:"serviceCandidate,omitempty"`
+	ValidFor         *TimePeriod           `json:"validFor,omitempty"`
+	// Category version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// serviceCategoryFields are the attributes defined in the ServiceCategory schema
+var serviceCategoryFields = map[string]bool{
+	"@baseType":        true,
+	"@schemaLocation":  true,
+	"@type":            true,
+	"category":         true,
+	"description":      true,
+	"href":             true,
+	"id":               true,
+	"isRoot":           true,
+	"lastUpdate":       true,
+	"lifecycleStatus":  true,
+	"name":             true,
+	"parentId":         true,
+	"serviceCandidate": true,
+	"validFor":         true,
+	"version":          true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ServiceCategory) UnmarshalJSON(data []byte) error {
+	type plain ServiceCategory
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, serviceCategoryFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ServiceCategory) MarshalJSON() ([]byte, error) {
+	type plain ServiceCategory
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ServiceCategoryRef defines model for the ServiceCategoryRef schema.
+// Reference to a service category in the catalog.
+type ServiceCategoryRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Version of the category
+	Version string `json:"version,omitempty"`
+}
+
+// ServiceRef defines model for the ServiceRef schema.
+// Service reference, for when Service is used by other entities.
+type ServiceRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceSpecification defines model for the ServiceSpecification schema.
+// ServiceSpecification is a class that offers characteristics to describe a type of service.
+type ServiceSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Attachments such as pictures or documents describing the service
+	Attachment []AttachmentRefOrValue `json:"attachment,omitempty"`
+	// List of categories of this specification
+	Category []ServiceCategoryRef `json:"category,omitempty"`
+	// Description of the specification
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// isBundle determines whether a ServiceSpecification represents a single ServiceSpecification (false), or a bundle of ServiceSpecification (true).
+	IsBundle *bool `json:"isBundle,omitempty"`
+	// Date and time of the last update of the specification
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status of the service specification
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name given to the specification
+	Name string `json:"name,omitempty"`
+	// Parties who manage or otherwise have an interest in this specification
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// Resource specifications required to realize the service
+	ResourceSpecification []ResourceSpecificationRef `json:"resourceSpecification,omitempty"`
+	// Relationships to other service specifications
+	ServiceSpecRelationship []ServiceSpecificationRelationship `json:"serviceSpecRelationship,omitempty"`
+	// List of characteristics that the service can take
+	SpecCharacteristic []CharacteristicSpecification `json:"specCharacteristic,omitempty"`
+	ValidFor           *TimePeriod                   `json:"validFor,omitempty"`
+	// Service specification version
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// serviceSpecificationFields are the attributes defined in the ServiceSpecification schema
+var serviceSpecificationFields = map[string]bool{
+	"@baseType":               true,
+	"@schemaLocation":         true,
+	"@type":                   true,
+	"attachment":              true,
+	"category":                true,
+	"description":             true,
+	"href":                    true,
+	"id":                      true,
+	"isBundle":                true,
+	"lastUpdate":              true,
+	"lifecycleStatus":         true,
+	"name":                    true,
+	"relatedParty":            true,
+	"resourceSpecification":   true,
+	"serviceSpecRelationship": true,
+	"specCharacteristic":      true,
+	"validFor":                true,
+	"version":                 true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *ServiceSpecification) UnmarshalJSON(data []byte) error {
+	type plain ServiceSpecification
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, serviceSpecificationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o ServiceSpecification) MarshalJSON() ([]byte, error) {
+	type plain ServiceSpecification
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// ServiceSpecificationRef defines model for the ServiceSpecificationRef schema.
+// Service specification reference: ServiceSpecification(s) required to realize a ProductSpecification.
+type ServiceSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+	// Service specification version
+	Version string `json:"version,omitempty"`
+}
+
+// ServiceSpecificationRelationship defines model for the ServiceSpecificationRelationship schema.
+// A migration, substitution, dependency or exclusivity relationship between/among service specifications.
+type ServiceSpecificationRelationship struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference to the target specification
+	Href string `json:"href,omitempty"`
+	// Unique identifier of the target service specification
+	ID string `json:"id,omitempty"`
+	// Name of the target specification
+	Name string `json:"name,omitempty"`
+	// Type of relationship such as migration, substitution, dependency, exclusivity
+	RelationshipType string `json:"relationshipType,omitempty"`
+	// The association role for this service specification
+	Role     string      `json:"role,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// Skill defines model for the Skill schema.
+// Skills evaluated for an individual with a level and possibly with a limited validity when an obsolescence is defined (Ex: the first-aid certificate first level is limited to one year and an update training is required each year to keep the level).
+type Skill struct {
+	// A free text comment linked to the evaluation done
+	Comment string `json:"comment,omitempty"`
+	// Level of expertise in a skill evaluated for an individual
+	EvaluatedLevel string `json:"evaluatedLevel,omitempty"`
+	// Code of the skill
+	SkillCode string `json:"skillCode,omitempty"`
+	// Name of the skill, such as Java language
+	SkillName string      `json:"skillName,omitempty"`
+	ValidFor  *TimePeriod `json:"validFor,omitempty"`
+}
+
+// TargetProductSchema defines model for the TargetProductSchema schema.
+// The reference object to the schema and type of target product which is described by product specification
+type TargetProductSchema struct {
+	// This field provides a link to the schema describing the target product
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// Class type of the target product
+	AtType string `json:"@type,omitempty"`
+}
+
+// TaxDefinition defines model for the TaxDefinition schema.
+// Reference of a tax definition.
+type TaxDefinition struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Unique identifier of the tax.
+	ID string `json:"id,omitempty"`
+	// Level of the jurisdiction that levies the tax
+	JurisdictionLevel string `json:"jurisdictionLevel,omitempty"`
+	// Name of the jurisdiction that levies the tax
+	JurisdictionName string `json:"jurisdictionName,omitempty"`
+	// Tax name.
+	Name string `json:"name,omitempty"`
+	// Type of the tax.
+	TaxType  string      `json:"taxType,omitempty"`
+	ValidFor *TimePeriod `json:"validFor,omitempty"`
+}
+
+// TaxExemptionCertificate defines model for the TaxExemptionCertificate schema.
+// A tax exemption certificate represents a tax exemption granted to a party (individual or organization) by a tax jurisdiction which may be a city, state, country,...
+type TaxExemptionCertificate struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType     string                `json:"@type,omitempty"`
+	Attachment *AttachmentRefOrValue `json:"attachment,omitempty"`
+	// Identifier of a document that shows proof of exemption from taxes for the taxing jurisdiction
+	CertificateNumber string `json:"certificateNumber,omitempty"`
+	// Identifier of the tax exemption within list of the exemptions
+	ID string `json:"id,omitempty"`
+	// Name of the jurisdiction that issued the exemption
+	IssuingJurisdiction string `json:"issuingJurisdiction,omitempty"`
+	// Reason for the tax exemption
+	Reason string `json:"reason,omitempty"`
+	// A list of taxes that are covered by the exemption, e.g. City Tax, State Tax.
+	TaxDefinition []TaxDefinition `json:"taxDefinition,omitempty"`
+	ValidFor      *TimePeriod     `json:"validFor,omitempty"`
+}
+
+// TaxItem defines model for the TaxItem schema.
+// A tax item is created for each tax rate and tax type used in the bill.
+type TaxItem struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType    string `json:"@type,omitempty"`
+	TaxAmount *Money `json:"taxAmount,omitempty"`
+	// Tax category
+	TaxCategory string `json:"taxCategory,omitempty"`
+	// Applied rate of the tax
+	TaxRate *float64 `json:"taxRate,omitempty"`
+}
+
+// TimePeriod defines model for the TimePeriod schema.
+// A period of time, either as a deadline (endDateTime only) a startDateTime only, or both
+type TimePeriod struct {
+	// End of the time period, using IETC-RFC-3339 format
+	EndDateTime *time.Time `json:"endDateTime,omitempty"`
+	// Start of the time period, using IETC-RFC-3339 format
+	StartDateTime *time.Time `json:"startDateTime,omitempty"`
+}
+
+// Usage defines model for the Usage schema.
+// An occurrence of employing a Product, Service, or Resource for its intended purpose, which is of interest to the business and can have charges applied to it.
+type Usage struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of usage
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Rating information of the usage
+	RatedProductUsage []RatedProductUsage `json:"ratedProductUsage,omitempty"`
+	// Parties related to the usage
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	Status       string                          `json:"status,omitempty"`
+	// Characteristics of the usage, like the volume consumed
+	UsageCharacteristic []Characteristic `json:"usageCharacteristic,omitempty"`
+	// Date of usage
+	UsageDate          *time.Time             `json:"usageDate,omitempty"`
+	UsageSpecification *UsageSpecificationRef `json:"usageSpecification,omitempty"`
+	// Type of usage
+	UsageType string `json:"usageType,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// usageFields are the attributes defined in the Usage schema
+var usageFields = map[string]bool{
+	"@baseType":           true,
+	"@schemaLocation":     true,
+	"@type":               true,
+	"description":         true,
+	"href":                true,
+	"id":                  true,
+	"ratedProductUsage":   true,
+	"relatedParty":        true,
+	"status":              true,
+	"usageCharacteristic": true,
+	"usageDate":           true,
+	"usageSpecification":  true,
+	"usageType":           true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *Usage) UnmarshalJSON(data []byte) error {
+	type plain Usage
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, usageFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o Usage) MarshalJSON() ([]byte, error) {
+	type plain Usage
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// UsageSpecification defines model for the UsageSpecification schema.
+// A detailed description of a usage event that are of interest to the business and can have charges applied to it.
+type UsageSpecification struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Description of the specification
+	Description string `json:"description,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Date and time of the last update
+	LastUpdate *time.Time `json:"lastUpdate,omitempty"`
+	// Used to indicate the current lifecycle status
+	LifecycleStatus string `json:"lifecycleStatus,omitempty"`
+	// Name of the specification
+	Name string `json:"name,omitempty"`
+	// Parties related to the specification
+	RelatedParty []RelatedPartyRefOrPartyRoleRef `json:"relatedParty,omitempty"`
+	// Characteristics that the usage can take
+	SpecCharacteristic []CharacteristicSpecification `json:"specCharacteristic,omitempty"`
+	ValidFor           *TimePeriod                   `json:"validFor,omitempty"`
+	// Version of the specification
+	Version string `json:"version,omitempty"`
+
+	// Extensions are the attributes of the object not defined in the schema
+	Extensions map[string]any `json:"-"`
+}
+
+// usageSpecificationFields are the attributes defined in the UsageSpecification schema
+var usageSpecificationFields = map[string]bool{
+	"@baseType":          true,
+	"@schemaLocation":    true,
+	"@type":              true,
+	"description":        true,
+	"href":               true,
+	"id":                 true,
+	"lastUpdate":         true,
+	"lifecycleStatus":    true,
+	"name":               true,
+	"relatedParty":       true,
+	"specCharacteristic": true,
+	"validFor":           true,
+	"version":            true,
+}
+
+// UnmarshalJSON decodes the object, keeping the attributes not in the schema in Extensions.
+func (o *UsageSpecification) UnmarshalJSON(data []byte) error {
+	type plain UsageSpecification
+	if err := json.Unmarshal(data, (*plain)(o)); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data, usageSpecificationFields)
+	o.Extensions = ext
+	return err
+}
+
+// MarshalJSON encodes the object, including the attributes in Extensions.
+func (o UsageSpecification) MarshalJSON() ([]byte, error) {
+	type plain UsageSpecification
+	return marshalWithExtensions(plain(o), o.Extensions)
+}
+
+// UsageSpecificationRef defines model for the UsageSpecificationRef schema.
+// UsageSpecification reference.
+type UsageSpecificationRef struct {
+	// When sub-classing, this defines the super-class
+	AtBaseType string `json:"@baseType,omitempty"`
+	// The actual type of the target instance when needed for disambiguation.
+	AtReferredType string `json:"@referredType,omitempty"`
+	// A URI to a JSON-Schema file that defines additional attributes and relationships
+	AtSchemaLocation string `json:"@schemaLocation,omitempty"`
+	// When sub-classing, this defines the sub-class Extensible name
+	AtType string `json:"@type,omitempty"`
+	// Hyperlink reference
+	Href string `json:"href,omitempty"`
+	// unique identifier
+	ID string `json:"id,omitempty"`
+	// Name of the referred entity.
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,92 @@
+package echo
+
+import (
+	"io"
+	"net/url"
+
+	svc "github.com/hesusruiz/isbetmf/tmfserver/service"
+	"github.com/labstack/echo/v4"
+)
+
+// newEventRequest builds a service request for the TMF688 Event Management API
+func newEventRequest(c echo.Context, action string) *svc.Request {
+	body, _ := io.ReadAll(c.Request().Body)
+	idParam, _ := url.QueryUnescape(c.Param("id"))
+	topicParam, _ := url.QueryUnescape(c.Param("topicId"))
+
+	return &svc.Request{
+		Method:      c.Request().Method,
+		Action:      action,
+		APIfamily:   svc.EventManagementAPI,
+		ID:          idParam,
+		TopicID:     topicParam,
+		QueryParams: c.QueryParams(),
+		Body:        body,
+		AccessToken: svc.ExtractJWTToken(c.Request().Header.Get("Authorization")),
+	}
+}
+
+// ListTopics lists the TMF688 topics
+func (h *Handler) ListTopics(c echo.Context) error {
+	return sendResponse(c, h.service.ListTopics(newEventRequest(c, "LIST")))
+}
+
+// CreateTopic creates a TMF688 topic
+func (h *Handler) CreateTopic(c echo.Context) error {
+	return sendResponse(c, h.service.CreateTopic(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// GetTopic retrieves a TMF688 topic
+func (h *Handler) GetTopic(c echo.Context) error {
+	return sendResponse(c, h.service.GetTopic(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// DeleteTopic deletes a TMF688 topic
+func (h *Handler) DeleteTopic(c echo.Context) error {
+	return sendResponse(c, h.service.DeleteTopic(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// ListEvents lists the recent events, of all topics or of the topic in the path
+func (h *Handler) ListEvents(c echo.Context) error {
+	return sendResponse(c, h.service.ListEvents(newEventRequest(c, "LIST")))
+}
+
+// GetEvent retrieves a recent event
+func (h *Handler) GetEvent(c echo.Context) error {
+	return sendResponse(c, h.service.GetEvent(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// CreateEvent publishes an event on a topic
+func (h *Handler) CreateEvent(c echo.Context) error {
+	return sendResponse(c, h.service.CreateEvent(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// ListTopicHubs lists the subscriptions to a topic
+func (h *Handler) ListTopicHubs(c echo.Context) error {
+	return sendResponse(c, h.service.ListTopicHubs(newEventRequest(c, "LIST")))
+}
+
+// CreateTopicHub creates a subscription to a topic
+func (h *Handler) CreateTopicHub(c echo.Context) error {
+	return sendResponse(c, h.service.CreateTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// GetTopicHub retrieves a subscription to a topic
+func (h *Handler) GetTopicHub(c echo.Context) error {
+	return sendResponse(c, h.service.GetTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// DeleteTopicHub deletes a subscription to a topic
+func (h *Handler) DeleteTopicHub(c echo.Context) error {
+	return sendResponse(c, h.service.DeleteTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// CreateEventHub creates a subscription to the events of the Event Management API itself
+func (h *Handler) CreateEventHub(c echo.Context) error {
+	return sendResponse(c, h.service.CreateHubSubscription(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
+
+// DeleteEventHub deletes a subscription to the events of the Event Management API itself
+func (h *Handler) DeleteEventHub(c echo.Context) error {
+	return sendResponse(c, h.service.DeleteHubSubscription(newEventRequest(c, svc.HttpMethodAliases[c.Request().Method])))
+}
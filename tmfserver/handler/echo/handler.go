@@ -26,6 +26,28 @@ func (h *Handler) HelloWorld(c echo.Context) error {
 	return sendResponse(c, resp)
 }
 
+// ListAPIs returns the API families implemented by the server, with the URLs of their OpenAPI documents
+func (h *Handler) ListAPIs(c echo.Context) error {
+	return sendResponse(c, h.service.ListAPIs())
+}
+
+// GetOpenAPIDocument returns the OpenAPI document of an API family, pointing to this server
+func (h *Handler) GetOpenAPIDocument(c echo.Context) error {
+	serverRoot := c.Scheme() + "://" + c.Request().Host
+	return sendResponse(c, h.service.GetOpenAPIDocument(c.Param("apiFamily"), serverRoot))
+}
+
+// CheckRoute is a middleware which rejects with 404 the requests to API families and resources
+// not implemented by the server.
+func (h *Handler) CheckRoute(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if resp := h.service.CheckRoute(c.Param("apiFamily"), c.Param("resourceName")); resp != nil {
+			return sendResponse(c, resp)
+		}
+		return next(c)
+	}
+}
+
 // CreateGenericObject creates a new TMF object using generalized parameters.
 func (h *Handler) CreateGenericObject(c echo.Context) error {
 	body, _ := io.ReadAll(c.Request().Body)
@@ -40,7 +62,15 @@ func (h *Handler) CreateGenericObject(c echo.Context) error {
 		AccessToken:  jwtToken, // Store the raw JWT token
 	}
 
-	resp := h.service.CreateGenericObject(req)
+	var resp *svc.Response
+	switch req.ResourceName {
+	case "exportJob":
+		resp = h.service.CreateExportJob(req)
+	case "importJob":
+		resp = h.service.CreateImportJob(req)
+	default:
+		resp = h.service.CreateGenericObject(req)
+	}
 	return sendResponse(c, resp)
 }
 
@@ -91,6 +121,11 @@ func (h *Handler) DeleteGenericObject(c echo.Context) error {
 		AccessToken:  jwtToken, // Store the raw JWT token
 	}
 
+	// Jobs have files which are deleted with them
+	if svc.IsJobResource(req.ResourceName) {
+		return sendResponse(c, h.service.DeleteJob(req))
+	}
+
 	resp := h.service.DeleteGenericObject(req)
 	return sendResponse(c, resp)
 }
@@ -111,6 +146,54 @@ func (h *Handler) ListGenericObjects(c echo.Context) error {
 	return sendResponse(c, resp)
 }
 
+// BulkOperations runs a list of create, update and delete operations on the resources of an API family
+func (h *Handler) BulkOperations(c echo.Context) error {
+	body, _ := io.ReadAll(c.Request().Body)
+	jwtToken := svc.ExtractJWTToken(c.Request().Header.Get("Authorization"))
+
+	req := &svc.Request{
+		Method:      c.Request().Method,
+		Action:      svc.HttpMethodAliases[c.Request().Method],
+		APIfamily:   c.Param("apiFamily"),
+		QueryParams: c.QueryParams(),
+		Body:        body,
+		AccessToken: jwtToken,
+	}
+
+	resp := h.service.BulkOperations(req)
+	return sendResponse(c, resp)
+}
+
+// GetJobContent returns the file with the objects exported by an export job
+func (h *Handler) GetJobContent(c echo.Context) error {
+	return h.sendJobFile(c, true)
+}
+
+// GetJobLog returns the log of an import or export job
+func (h *Handler) GetJobLog(c echo.Context) error {
+	return h.sendJobFile(c, false)
+}
+
+func (h *Handler) sendJobFile(c echo.Context, content bool) error {
+	jwtToken := svc.ExtractJWTToken(c.Request().Header.Get("Authorization"))
+
+	req := &svc.Request{
+		Method:       c.Request().Method,
+		Action:       svc.HttpMethodAliases[c.Request().Method],
+		APIfamily:    c.Param("apiFamily"),
+		ResourceName: c.Param("resourceName"),
+		ID:           c.Param("id"),
+		AccessToken:  jwtToken,
+	}
+
+	fileName, contentType, resp := h.service.JobFile(req, content)
+	if resp != nil {
+		return sendResponse(c, resp)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	return c.File(fileName)
+}
+
 func sendResponse(c echo.Context, resp *svc.Response) error {
 	for key, value := range resp.Headers {
 		c.Response().Header().Set(key, value)
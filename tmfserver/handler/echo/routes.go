@@ -9,8 +9,38 @@ import (
 func (h *Handler) RegisterRoutes(e *echo.Echo) {
 	e.Pre(middleware.RemoveTrailingSlash())
 
-	// Group routes for TMF API
-	tmfApi := e.Group("/tmf-api/:apiFamily/v5")
+	// TMF688 Event Management API, backed by the notifications manager
+	eventApi := e.Group("/tmf-api/eventManagement/v4")
+	eventApi.GET("/event", h.ListEvents)
+	eventApi.GET("/event/:id", h.GetEvent)
+	eventApi.GET("/topic", h.ListTopics)
+	eventApi.POST("/topic", h.CreateTopic)
+	eventApi.GET("/topic/:id", h.GetTopic)
+	eventApi.DELETE("/topic/:id", h.DeleteTopic)
+	eventApi.GET("/topic/:topicId/event", h.ListEvents)
+	eventApi.POST("/topic/:topicId/event", h.CreateEvent)
+	eventApi.GET("/topic/:topicId/event/:id", h.GetEvent)
+	eventApi.GET("/topic/:topicId/hub", h.ListTopicHubs)
+	eventApi.POST("/topic/:topicId/hub", h.CreateTopicHub)
+	eventApi.GET("/topic/:topicId/hub/:id", h.GetTopicHub)
+	eventApi.DELETE("/topic/:topicId/hub/:id", h.DeleteTopicHub)
+	eventApi.POST("/hub", h.CreateEventHub)
+	eventApi.DELETE("/hub/:id", h.DeleteEventHub)
+
+	// Group routes for TMF API.
+	// Only the API families and resources declared in the OpenAPI specs are served.
+	tmfApi := e.Group("/tmf-api/:apiFamily/v5", h.CheckRoute)
+
+	// Index of the API families implemented, and the OpenAPI document of each one
+	e.GET("/tmf-api", h.ListAPIs)
+	tmfApi.GET("/openapi.json", h.GetOpenAPIDocument)
+
+	// Create, update and delete objects in bulk
+	tmfApi.POST("/bulk", h.BulkOperations)
+
+	// Files of the import and export jobs. The jobs themselves are managed with the generalized routes.
+	tmfApi.GET("/:resourceName/:id/content", h.GetJobContent)
+	tmfApi.GET("/:resourceName/:id/errorLog", h.GetJobLog)
 
 	// Generalized routes for TMF API resources
 	// Collection operations (List and Create)
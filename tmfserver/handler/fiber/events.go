@@ -0,0 +1,91 @@
+package fiber
+
+import (
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+	svc "github.com/hesusruiz/isbetmf/tmfserver/service"
+)
+
+// newEventRequest builds a service request for the TMF688 Event Management API
+func newEventRequest(c *fiber.Ctx, action string) *svc.Request {
+	queryParams, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	idParam, _ := url.QueryUnescape(c.Params("id"))
+	topicParam, _ := url.QueryUnescape(c.Params("topicId"))
+
+	return &svc.Request{
+		Method:      c.Method(),
+		Action:      action,
+		APIfamily:   svc.EventManagementAPI,
+		ID:          idParam,
+		TopicID:     topicParam,
+		QueryParams: queryParams,
+		Body:        c.Body(),
+		AccessToken: svc.ExtractJWTToken(c.Get("Authorization")),
+	}
+}
+
+// ListTopics lists the TMF688 topics
+func (h *Handler) ListTopics(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.ListTopics(newEventRequest(c, "LIST")))
+}
+
+// CreateTopic creates a TMF688 topic
+func (h *Handler) CreateTopic(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.CreateTopic(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// GetTopic retrieves a TMF688 topic
+func (h *Handler) GetTopic(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.GetTopic(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// DeleteTopic deletes a TMF688 topic
+func (h *Handler) DeleteTopic(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.DeleteTopic(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// ListEvents lists the recent events, of all topics or of the topic in the path
+func (h *Handler) ListEvents(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.ListEvents(newEventRequest(c, "LIST")))
+}
+
+// GetEvent retrieves a recent event
+func (h *Handler) GetEvent(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.GetEvent(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// CreateEvent publishes an event on a topic
+func (h *Handler) CreateEvent(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.CreateEvent(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// ListTopicHubs lists the subscriptions to a topic
+func (h *Handler) ListTopicHubs(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.ListTopicHubs(newEventRequest(c, "LIST")))
+}
+
+// CreateTopicHub creates a subscription to a topic
+func (h *Handler) CreateTopicHub(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.CreateTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// GetTopicHub retrieves a subscription to a topic
+func (h *Handler) GetTopicHub(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.GetTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// DeleteTopicHub deletes a subscription to a topic
+func (h *Handler) DeleteTopicHub(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.DeleteTopicHub(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// CreateEventHub creates a subscription to the events of the Event Management API itself
+func (h *Handler) CreateEventHub(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.CreateHubSubscription(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
+
+// DeleteEventHub deletes a subscription to the events of the Event Management API itself
+func (h *Handler) DeleteEventHub(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.DeleteHubSubscription(newEventRequest(c, svc.HttpMethodAliases[c.Method()])))
+}
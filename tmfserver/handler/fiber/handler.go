@@ -2,6 +2,7 @@ package fiber
 
 import (
 	"net/url"
+	"strings"
 
 	"encoding/json"
 	"log/slog"
@@ -62,6 +63,85 @@ func (h *Handler) DeleteHubSubscription(c *fiber.Ctx) error {
 	return sendResponse(c, resp)
 }
 
+// GetHubSubscription retrieves a notification subscription (hub) and its delivery status
+func (h *Handler) GetHubSubscription(c *fiber.Ctx) error {
+	jwtToken := svc.ExtractJWTToken(c.Get("Authorization"))
+
+	idParam, _ := url.QueryUnescape(c.Params("id"))
+	req := &svc.Request{
+		Method:      c.Method(),
+		Action:      svc.HttpMethodAliases[c.Method()],
+		APIfamily:   c.Params("apiFamily"),
+		ID:          idParam,
+		AccessToken: jwtToken,
+	}
+
+	resp := h.service.GetHubSubscription(req)
+	return sendResponse(c, resp)
+}
+
+// UpdateHubSubscription changes the status of a notification subscription (hub), to resume it
+func (h *Handler) UpdateHubSubscription(c *fiber.Ctx) error {
+	jwtToken := svc.ExtractJWTToken(c.Get("Authorization"))
+
+	idParam, _ := url.QueryUnescape(c.Params("id"))
+	req := &svc.Request{
+		Method:      c.Method(),
+		Action:      svc.HttpMethodAliases[c.Method()],
+		APIfamily:   c.Params("apiFamily"),
+		ID:          idParam,
+		Body:        c.Body(),
+		AccessToken: jwtToken,
+	}
+
+	resp := h.service.UpdateHubSubscription(req)
+	return sendResponse(c, resp)
+}
+
+// Metrics exposes the delivery metrics of the hub subscriptions in the Prometheus text format,
+// to the scrapers with the bearer token configured
+func (h *Handler) Metrics(c *fiber.Ctx) error {
+	req := &svc.Request{AccessToken: svc.ExtractJWTToken(c.Get("Authorization"))}
+	if resp := h.service.CheckMetricsAccess(req); resp != nil {
+		return sendResponse(c, resp)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/plain; version=0.0.4; charset=utf-8")
+	return h.service.Notifications().WriteMetrics(c)
+}
+
+// ListAPIs returns the API families implemented by the server, with the URLs of their OpenAPI documents
+func (h *Handler) ListAPIs(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.ListAPIs())
+}
+
+// GetOpenAPIDocument returns the OpenAPI document of an API family, pointing to this server
+func (h *Handler) GetOpenAPIDocument(c *fiber.Ctx) error {
+	return sendResponse(c, h.service.GetOpenAPIDocument(c.Params("apiFamily"), c.BaseURL()))
+}
+
+// CheckRoute is a middleware which rejects with 404 the requests to API families and resources
+// not implemented by the server. It runs before the routes are matched, so the resource name is
+// taken from the first path segment after the API prefix.
+func (h *Handler) CheckRoute(c *fiber.Ctx) error {
+	apiFamily := c.Params("apiFamily")
+	prefix := "/tmf-api/" + apiFamily + "/v5/"
+
+	resourceName := ""
+	if rest, ok := strings.CutPrefix(c.Path(), prefix); ok {
+		resourceName, _, _ = strings.Cut(rest, "/")
+	}
+	if resourceName == "hub" || resourceName == "openapi.json" || resourceName == "bulk" {
+		// The notifications hub, the OpenAPI document and bulk operations are available in all API families
+		resourceName = ""
+	}
+
+	if resp := h.service.CheckRoute(apiFamily, resourceName); resp != nil {
+		return sendResponse(c, resp)
+	}
+	return c.Next()
+}
+
 // MockListener is a minimal endpoint to receive notifications locally for testing
 func (h *Handler) MockListener(c *fiber.Ctx) error {
 	path := string(c.Request().URI().Path())
@@ -98,7 +178,15 @@ func (h *Handler) CreateGenericObject(c *fiber.Ctx) error {
 		AccessToken:  jwtToken, // Store the raw JWT token
 	}
 
-	resp := h.service.CreateGenericObject(req)
+	var resp *svc.Response
+	switch resourceName {
+	case "exportJob":
+		resp = h.service.CreateExportJob(req)
+	case "importJob":
+		resp = h.service.CreateImportJob(req)
+	default:
+		resp = h.service.CreateGenericObject(req)
+	}
 	return sendResponse(c, resp)
 }
 
@@ -111,6 +199,7 @@ func (h *Handler) GetGenericObject(c *fiber.Ctx) error {
 	req := &svc.Request{
 		Method:       c.Method(),
 		Action:       svc.HttpMethodAliases[c.Method()],
+		APIfamily:    c.Params("apiFamily"),
 		ResourceName: c.Params("resourceName"),
 		ID:           idParam,
 		QueryParams:  queryParams,
@@ -129,6 +218,7 @@ func (h *Handler) UpdateGenericObject(c *fiber.Ctx) error {
 	req := &svc.Request{
 		Method:       c.Method(),
 		Action:       svc.HttpMethodAliases[c.Method()],
+		APIfamily:    c.Params("apiFamily"),
 		ResourceName: c.Params("resourceName"),
 		ID:           idParam,
 		Body:         c.Body(),
@@ -153,11 +243,17 @@ func (h *Handler) DeleteGenericObject(c *fiber.Ctx) error {
 	req := &svc.Request{
 		Method:       c.Method(),
 		Action:       svc.HttpMethodAliases[c.Method()],
+		APIfamily:    c.Params("apiFamily"),
 		ResourceName: c.Params("resourceName"),
 		ID:           idParam,
 		AccessToken:  jwtToken, // Store the raw JWT token
 	}
 
+	// Jobs have files which are deleted with them
+	if svc.IsJobResource(resourceName) {
+		return sendResponse(c, h.service.DeleteJob(req))
+	}
+
 	resp := h.service.DeleteGenericObject(req)
 	return sendResponse(c, resp)
 }
@@ -170,6 +266,7 @@ func (h *Handler) ListGenericObjects(c *fiber.Ctx) error {
 	req := &svc.Request{
 		Method:       c.Method(),
 		Action:       "LIST",
+		APIfamily:    c.Params("apiFamily"),
 		ResourceName: c.Params("resourceName"),
 		QueryParams:  queryParams,
 		AccessToken:  jwtToken, // Store the raw JWT token
@@ -179,6 +276,58 @@ func (h *Handler) ListGenericObjects(c *fiber.Ctx) error {
 	return sendResponse(c, resp)
 }
 
+// BulkOperations runs a list of create, update and delete operations on the resources of an API family
+func (h *Handler) BulkOperations(c *fiber.Ctx) error {
+	jwtToken := svc.ExtractJWTToken(c.Get("Authorization"))
+
+	queryParams, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	req := &svc.Request{
+		Method:      c.Method(),
+		Action:      svc.HttpMethodAliases[c.Method()],
+		APIfamily:   c.Params("apiFamily"),
+		QueryParams: queryParams,
+		Body:        c.Body(),
+		AccessToken: jwtToken,
+	}
+
+	resp := h.service.BulkOperations(req)
+	return sendResponse(c, resp)
+}
+
+// GetJobContent returns the file with the objects exported by an export job
+func (h *Handler) GetJobContent(c *fiber.Ctx) error {
+	return h.sendJobFile(c, true)
+}
+
+// GetJobLog returns the log of an import or export job
+func (h *Handler) GetJobLog(c *fiber.Ctx) error {
+	return h.sendJobFile(c, false)
+}
+
+func (h *Handler) sendJobFile(c *fiber.Ctx, content bool) error {
+	jwtToken := svc.ExtractJWTToken(c.Get("Authorization"))
+
+	idParam, _ := url.QueryUnescape(c.Params("id"))
+	req := &svc.Request{
+		Method:       c.Method(),
+		Action:       svc.HttpMethodAliases[c.Method()],
+		APIfamily:    c.Params("apiFamily"),
+		ResourceName: c.Params("resourceName"),
+		ID:           idParam,
+		AccessToken:  jwtToken,
+	}
+
+	fileName, contentType, resp := h.service.JobFile(req, content)
+	if resp != nil {
+		return sendResponse(c, resp)
+	}
+	if err := c.SendFile(fileName); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	return nil
+}
+
 func sendResponse(c *fiber.Ctx, resp *svc.Response) error {
 	for key, value := range resp.Headers {
 		c.Set(key, value)
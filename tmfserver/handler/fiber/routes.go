@@ -13,13 +13,53 @@ func (h *Handler) RegisterRoutes(app *fiber.App) {
 	// Health check)
 	app.Get("/health", h.Health)
 
+	// Delivery metrics of the notifications, in Prometheus format, only with the token of the scrapers
+	app.Get("/metrics", h.Metrics)
+
+	// Index of the API families implemented, used by the OpenAPI UI
+	app.Get("/tmf-api", h.ListAPIs)
+
+	// TMF688 Event Management API, backed by the notifications manager
+	eventApi := app.Group("/tmf-api/eventManagement/v4")
+	eventApi.Get("/event", h.ListEvents)
+	eventApi.Get("/event/:id", h.GetEvent)
+	eventApi.Get("/topic", h.ListTopics)
+	eventApi.Post("/topic", h.CreateTopic)
+	eventApi.Get("/topic/:id", h.GetTopic)
+	eventApi.Delete("/topic/:id", h.DeleteTopic)
+	eventApi.Get("/topic/:topicId/event", h.ListEvents)
+	eventApi.Post("/topic/:topicId/event", h.CreateEvent)
+	eventApi.Get("/topic/:topicId/event/:id", h.GetEvent)
+	eventApi.Get("/topic/:topicId/hub", h.ListTopicHubs)
+	eventApi.Post("/topic/:topicId/hub", h.CreateTopicHub)
+	eventApi.Get("/topic/:topicId/hub/:id", h.GetTopicHub)
+	eventApi.Delete("/topic/:topicId/hub/:id", h.DeleteTopicHub)
+	eventApi.Post("/hub", h.CreateEventHub)
+	eventApi.Delete("/hub/:id", h.DeleteEventHub)
+
 	// Group routes for TMF API
 	tmfApi := app.Group("/tmf-api/:apiFamily/v5")
 
+	// Only the API families and resources declared in the OpenAPI specs are served
+	tmfApi.Use(h.CheckRoute)
+
+	// OpenAPI document of the API family, with the server URL of this instance
+	tmfApi.Get("/openapi.json", h.GetOpenAPIDocument)
+
 	// Notifications Hub routes
+	tmfApi.Get("/hub/stream", h.StreamEvents)
 	tmfApi.Post("/hub", h.CreateHubSubscription)
+	tmfApi.Get("/hub/:id", h.GetHubSubscription)
+	tmfApi.Patch("/hub/:id", h.UpdateHubSubscription)
 	tmfApi.Delete("/hub/:id", h.DeleteHubSubscription)
 
+	// Create, update and delete objects in bulk
+	tmfApi.Post("/bulk", h.BulkOperations)
+
+	// Files of the import and export jobs. The jobs themselves are managed with the generalized routes.
+	tmfApi.Get("/:resourceName/:id/content", h.GetJobContent)
+	tmfApi.Get("/:resourceName/:id/errorLog", h.GetJobLog)
+
 	// Generalized routes for TMF API resources
 	// Collection operations (List and Create)
 	tmfApi.Get("/:resourceName", h.ListGenericObjects)
@@ -0,0 +1,102 @@
+package fiber
+
+import (
+	"bufio"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	svc "github.com/hesusruiz/isbetmf/tmfserver/service"
+)
+
+// StreamEvents streams the events of an API family using Server-Sent Events
+func (h *Handler) StreamEvents(c *fiber.Ctx) error {
+	jwtToken := svc.ExtractJWTToken(c.Get("Authorization"))
+
+	queryParams, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	// The standard SSE header takes precedence over the query parameter
+	if lastEventID := c.Get("Last-Event-ID"); lastEventID != "" {
+		queryParams.Set("lastEventId", lastEventID)
+	}
+
+	req := &svc.Request{
+		Method:      c.Method(),
+		Action:      "LIST",
+		APIfamily:   c.Params("apiFamily"),
+		QueryParams: queryParams,
+		AccessToken: jwtToken,
+	}
+
+	stream, errResp := h.service.OpenEventStream(req)
+	if errResp != nil {
+		return sendResponse(c, errResp)
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer stream.Close()
+
+		write := func(b []byte) bool {
+			if _, err := w.Write(b); err != nil {
+				return false
+			}
+			// A flush error means that the client disconnected
+			return w.Flush() == nil
+		}
+
+		// Tell the client how long to wait before reconnecting
+		if !write([]byte("retry: 3000\n\n")) {
+			return
+		}
+
+		for _, ev := range stream.Replay {
+			if !stream.Accept(ev) {
+				continue
+			}
+			msg, err := svc.FormatSSE(ev)
+			if err != nil {
+				slog.Error("failed to format event", slog.Any("error", err))
+				continue
+			}
+			if !write(msg) {
+				return
+			}
+		}
+
+		heartbeat := time.NewTicker(svc.StreamHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case ev, ok := <-stream.Live:
+				if !ok {
+					// The stream was closed because the client was too slow. It will reconnect
+					// with Last-Event-ID and resume from the event log.
+					return
+				}
+				if !stream.Accept(ev) {
+					continue
+				}
+				msg, err := svc.FormatSSE(ev)
+				if err != nil {
+					slog.Error("failed to format event", slog.Any("error", err))
+					continue
+				}
+				if !write(msg) {
+					return
+				}
+			case <-heartbeat.C:
+				if !write([]byte(": keep-alive\n\n")) {
+					return
+				}
+			}
+		}
+	})
+
+	return nil
+}
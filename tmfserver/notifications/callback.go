@@ -0,0 +1,278 @@
+package notifications
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidCallback is returned when a callback URL is rejected by the CallbackPolicy
+// or fails the verification handshake.
+type ErrInvalidCallback struct {
+	Callback string
+	Reason   string
+}
+
+func (e *ErrInvalidCallback) Error() string {
+	return fmt.Sprintf("invalid callback %q: %s", e.Callback, e.Reason)
+}
+
+func (e *ErrInvalidCallback) Is(target error) bool {
+	_, ok := target.(*ErrInvalidCallback)
+	return ok
+}
+
+// defaultDeniedCIDRs are the address ranges that subscribers are not allowed to target by default:
+// loopback, private networks, link-local (including cloud metadata endpoints), CGNAT,
+// unspecified and multicast addresses, both for IPv4 and IPv6.
+var defaultDeniedCIDRs = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+}
+
+// defaultDeniedHosts are host names which are always rejected, even before resolving them.
+var defaultDeniedHosts = []string{
+	"localhost",
+	"metadata",
+	"metadata.google.internal",
+}
+
+// CallbackPolicy defines which callback URLs can be used in hub subscriptions.
+//
+// The policy is enforced twice: when the subscription is created (on the URL and on the
+// addresses its host resolves to), and when the delivery client connects to the remote host.
+// The second check is done on the address actually dialed, so a DNS record that changes after
+// the subscription was accepted (DNS rebinding) can not be used to reach internal addresses.
+type CallbackPolicy struct {
+	// AllowedSchemes is the list of URL schemes accepted. Defaults to http and https.
+	AllowedSchemes []string
+
+	// AllowedHosts, if not empty, restricts callbacks to these host names.
+	// An entry starting with a dot (".example.com") matches any subdomain.
+	AllowedHosts []string
+
+	// DeniedHosts is a list of host names that are always rejected, with the same syntax as AllowedHosts.
+	DeniedHosts []string
+
+	// AllowedCIDRs are address ranges which are accepted even if they are included in DeniedCIDRs.
+	// For example, "127.0.0.0/8" can be used for local testing.
+	AllowedCIDRs []*net.IPNet
+
+	// DeniedCIDRs are address ranges which are rejected.
+	DeniedCIDRs []*net.IPNet
+
+	// VerifyCallbacks enables the verification handshake before a subscription becomes active.
+	VerifyCallbacks bool
+
+	// SinkSubscribers are the organizations which can subscribe with callbacks to the sinks of the
+	// server ("sink://<name>"). The sinks belong to the operator, so no one can use them by default.
+	SinkSubscribers []string
+}
+
+// DefaultCallbackPolicy returns a policy which rejects internal and special-purpose addresses.
+func DefaultCallbackPolicy() *CallbackPolicy {
+	p := &CallbackPolicy{
+		AllowedSchemes: []string{"http", "https"},
+		DeniedHosts:    slices.Clone(defaultDeniedHosts),
+	}
+	// The default list is well formed, so we can ignore the error
+	p.DeniedCIDRs, _ = ParseCIDRs(defaultDeniedCIDRs)
+	return p
+}
+
+// ParseCIDRs parses a list of CIDR ranges. A plain IP address is accepted as a single-host range.
+func ParseCIDRs(list []string) ([]*net.IPNet, error) {
+	var result []*net.IPNet
+	for _, s := range list {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address or CIDR: %s", s)
+			}
+			if ip.To4() != nil {
+				s = s + "/32"
+			} else {
+				s = s + "/128"
+			}
+		}
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s: %w", s, err)
+		}
+		result = append(result, ipnet)
+	}
+	return result, nil
+}
+
+// AddRules adds allow and deny rules to the policy. Each rule is either a CIDR range,
+// an IP address or a host name, and is classified accordingly.
+func (p *CallbackPolicy) AddRules(allow, deny []string) error {
+	for _, rule := range allow {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if isAddressRule(rule) {
+			nets, err := ParseCIDRs([]string{rule})
+			if err != nil {
+				return err
+			}
+			p.AllowedCIDRs = append(p.AllowedCIDRs, nets...)
+		} else {
+			p.AllowedHosts = append(p.AllowedHosts, strings.ToLower(rule))
+			// Explicitly allowing a host name removes it from the deny list
+			p.DeniedHosts = slices.DeleteFunc(p.DeniedHosts, func(h string) bool { return h == strings.ToLower(rule) })
+		}
+	}
+	for _, rule := range deny {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if isAddressRule(rule) {
+			nets, err := ParseCIDRs([]string{rule})
+			if err != nil {
+				return err
+			}
+			p.DeniedCIDRs = append(p.DeniedCIDRs, nets...)
+		} else {
+			p.DeniedHosts = append(p.DeniedHosts, strings.ToLower(rule))
+		}
+	}
+	return nil
+}
+
+// isAddressRule returns true if the rule is an IP address or a CIDR range
+func isAddressRule(rule string) bool {
+	if strings.Contains(rule, "/") {
+		return true
+	}
+	return net.ParseIP(rule) != nil
+}
+
+// ValidateURL checks the callback URL against the policy, resolving the host name
+// and checking all the addresses it resolves to.
+func (p *CallbackPolicy) ValidateURL(callback string) error {
+	u, err := url.Parse(callback)
+	if err != nil {
+		return &ErrInvalidCallback{Callback: callback, Reason: "malformed URL"}
+	}
+
+	if !slices.Contains(p.AllowedSchemes, strings.ToLower(u.Scheme)) {
+		return &ErrInvalidCallback{Callback: callback, Reason: fmt.Sprintf("scheme %q not allowed", u.Scheme)}
+	}
+
+	if u.User != nil {
+		return &ErrInvalidCallback{Callback: callback, Reason: "credentials in URL not allowed"}
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return &ErrInvalidCallback{Callback: callback, Reason: "missing host"}
+	}
+
+	if err := p.CheckHost(host); err != nil {
+		return &ErrInvalidCallback{Callback: callback, Reason: err.Error()}
+	}
+
+	// A literal IP address does not require resolution
+	if ip := net.ParseIP(host); ip != nil {
+		if err := p.CheckIP(ip); err != nil {
+			return &ErrInvalidCallback{Callback: callback, Reason: err.Error()}
+		}
+		return nil
+	}
+
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return &ErrInvalidCallback{Callback: callback, Reason: fmt.Sprintf("can not resolve host: %v", err)}
+	}
+	for _, ip := range ips {
+		if err := p.CheckIP(ip); err != nil {
+			return &ErrInvalidCallback{Callback: callback, Reason: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// CheckSinkSubscriber checks that the organization which owns a subscription can deliver its events to a sink
+func (p *CallbackPolicy) CheckSinkSubscriber(sub *Subscription) error {
+	if sub.Owner == "" || !slices.Contains(p.SinkSubscribers, sub.Owner) {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: "the subscriber is not allowed to use the sinks of the server"}
+	}
+	return nil
+}
+
+// CheckHost checks a host name against the allowed and denied host lists.
+func (p *CallbackPolicy) CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	if matchHost(p.DeniedHosts, host) {
+		return fmt.Errorf("host %s is denied", host)
+	}
+
+	if len(p.AllowedHosts) > 0 && !matchHost(p.AllowedHosts, host) {
+		// Literal IPs are subject only to CIDR rules when they are explicitly allowed
+		if ip := net.ParseIP(host); ip == nil || !containsIP(p.AllowedCIDRs, ip) {
+			return fmt.Errorf("host %s is not in the list of allowed hosts", host)
+		}
+	}
+
+	return nil
+}
+
+// CheckIP checks an address against the CIDR rules. Allowed ranges take precedence.
+func (p *CallbackPolicy) CheckIP(ip net.IP) error {
+	if containsIP(p.AllowedCIDRs, ip) {
+		return nil
+	}
+	if containsIP(p.DeniedCIDRs, ip) {
+		return fmt.Errorf("address %s is denied", ip.String())
+	}
+	return nil
+}
+
+// matchHost returns true if host matches any of the patterns
+func matchHost(patterns []string, host string) bool {
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, ".") {
+			if strings.HasSuffix(host, pattern) || host == pattern[1:] {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// containsIP returns true if any of the ranges contains the address
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
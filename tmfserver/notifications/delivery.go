@@ -2,26 +2,87 @@ package notifications
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
 	"time"
 )
 
 type httpDelivery struct {
 	client *http.Client
+	policy *CallbackPolicy
 }
 
 func NewHTTPDelivery(timeout time.Duration) DeliveryClient {
 	return &httpDelivery{client: &http.Client{Timeout: timeout}}
 }
 
+// NewHTTPDeliveryWithPolicy creates an HTTP delivery client which enforces the callback policy
+// on every connection, as described in NewPolicyHTTPClient.
+func NewHTTPDeliveryWithPolicy(timeout time.Duration, policy *CallbackPolicy) DeliveryClient {
+	return &httpDelivery{client: NewPolicyHTTPClient(timeout, policy), policy: policy}
+}
+
+// NewPolicyHTTPClient creates an HTTP client which enforces the callback policy on every connection.
+// The check is done on the address being dialed (after DNS resolution), so it is safe against DNS
+// rebinding. Redirects and environment proxies are not followed, because they would allow a remote
+// party to bypass the policy.
+func NewPolicyHTTPClient(timeout time.Duration, policy *CallbackPolicy) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("dialing non-IP address %s", address)
+			}
+			return policy.CheckIP(ip)
+		},
+	}
+
+	transport := &http.Transport{
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return errors.New("redirects not allowed by the callback policy")
+		},
+	}
+}
+
 func (d *httpDelivery) Deliver(sub *Subscription, payload any) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
 
+	if d.policy != nil {
+		if u, err := url.Parse(sub.Callback); err == nil {
+			if err := d.policy.CheckHost(u.Hostname()); err != nil {
+				return &ErrInvalidCallback{Callback: sub.Callback, Reason: err.Error()}
+			}
+		}
+	}
+
 	var lastErr error
 	for attempt := 0; attempt < 3; attempt++ {
 		req, err := http.NewRequest("POST", sub.Callback, bytes.NewReader(body))
@@ -42,6 +103,9 @@ func (d *httpDelivery) Deliver(sub *Subscription, payload any) error {
 		}
 		if resp != nil {
 			_ = resp.Body.Close()
+			if err == nil {
+				err = fmt.Errorf("callback returned status %d", resp.StatusCode)
+			}
 		}
 		lastErr = err
 		slog.Warn("notification delivery failed, will retry", slog.String("callback", sub.Callback), slog.Int("attempt", attempt+1))
@@ -49,3 +113,54 @@ func (d *httpDelivery) Deliver(sub *Subscription, payload any) error {
 	}
 	return lastErr
 }
+
+// Verify performs the verification handshake with the subscriber.
+// It sends a GET request to the callback with the 'hub.mode=subscribe' and a random 'hub.challenge'
+// query parameters, and expects a 2xx response whose body is exactly the challenge.
+// In this way we know that the owner of the callback endpoint really wants to receive the events.
+func (d *httpDelivery) Verify(sub *Subscription) error {
+	challengeBytes := make([]byte, 16)
+	if _, err := rand.Read(challengeBytes); err != nil {
+		return err
+	}
+	challenge := hex.EncodeToString(challengeBytes)
+
+	u, err := url.Parse(sub.Callback)
+	if err != nil {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: "malformed URL"}
+	}
+	q := u.Query()
+	q.Set("hub.mode", "subscribe")
+	q.Set("hub.challenge", challenge)
+	q.Set("hub.topic", sub.APIFamily)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: err.Error()}
+	}
+	if token, ok := sub.Headers["x-auth-token"]; ok && token != "" {
+		req.Header.Set("x-auth-token", token)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: fmt.Sprintf("verification request failed: %v", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: fmt.Sprintf("verification returned status %d", resp.StatusCode)}
+	}
+
+	// The challenge is small, so we limit what we read from the subscriber
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: "failed to read verification response"}
+	}
+	if strings.TrimSpace(string(body)) != challenge {
+		return &ErrInvalidCallback{Callback: sub.Callback, Reason: "verification challenge mismatch"}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package notifications
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultEventLogSize is the number of events retained by the Manager when no other size is set.
+const DefaultEventLogSize = 1000
+
+// Event is an event published by the Manager, as retained in the EventLog.
+type Event struct {
+	// Seq is a monotonically increasing sequence number assigned when the event is recorded.
+	// It can be used by consumers to resume reading after the last event they have seen.
+	Seq       uint64    `json:"seq"`
+	ID        string    `json:"eventId"`
+	APIFamily string    `json:"apiFamily"`
+	EventType string    `json:"eventType"`
+	Time      time.Time `json:"eventTime"`
+	Payload   any       `json:"payload"`
+}
+
+// EventFilter selects events from the EventLog. Zero values do not filter.
+type EventFilter struct {
+	APIFamily  string
+	EventTypes []string
+	From       time.Time // inclusive
+	To         time.Time // exclusive
+	AfterSeq   uint64
+	Limit      int
+}
+
+// EventLog is a bounded, in-memory log of the most recent events. When the log is full,
+// the oldest events are discarded. It is safe for concurrent use.
+type EventLog struct {
+	mu      sync.RWMutex
+	events  []*Event
+	size    int
+	lastSeq uint64
+}
+
+// NewEventLog creates an EventLog which retains at most size events.
+func NewEventLog(size int) *EventLog {
+	if size <= 0 {
+		size = DefaultEventLogSize
+	}
+	return &EventLog{size: size}
+}
+
+// Append records a new event, assigning its sequence number.
+func (l *EventLog) Append(ev *Event) *Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastSeq++
+	ev.Seq = l.lastSeq
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	l.events = append(l.events, ev)
+	if len(l.events) > l.size {
+		// Copy to avoid retaining the discarded prefix of the backing array
+		l.events = slices.Clone(l.events[len(l.events)-l.size:])
+	}
+	return ev
+}
+
+// Get returns the event with the given identifier, or nil if it is not (or no longer) in the log.
+func (l *EventLog) Get(id string) *Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, ev := range l.events {
+		if ev.ID == id {
+			return ev
+		}
+	}
+	return nil
+}
+
+// List returns the events matching the filter, oldest first.
+func (l *EventLog) List(f EventFilter) []*Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var result []*Event
+	for _, ev := range l.events {
+		if !f.Match(ev) {
+			continue
+		}
+		result = append(result, ev)
+		if f.Limit > 0 && len(result) >= f.Limit {
+			break
+		}
+	}
+	return result
+}
+
+// OldestSeq returns the sequence number of the oldest event retained, or zero if the log is empty.
+func (l *EventLog) OldestSeq() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if len(l.events) == 0 {
+		return 0
+	}
+	return l.events[0].Seq
+}
+
+// Match returns true if the event passes the filter
+func (f EventFilter) Match(ev *Event) bool {
+	if f.APIFamily != "" && ev.APIFamily != f.APIFamily {
+		return false
+	}
+	if len(f.EventTypes) > 0 && !slices.Contains(f.EventTypes, ev.EventType) {
+		return false
+	}
+	if !f.From.IsZero() && ev.Time.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !ev.Time.Before(f.To) {
+		return false
+	}
+	if f.AfterSeq > 0 && ev.Seq <= f.AfterSeq {
+		return false
+	}
+	return true
+}
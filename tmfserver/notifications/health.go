@@ -0,0 +1,243 @@
+package notifications
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Status of a subscription, depending on the results of the deliveries to its callback
+const (
+	StatusActive    = "active"
+	StatusSuspended = "suspended"
+)
+
+// DefaultSuspendAfter is the number of consecutive failed deliveries after which a subscription
+// is suspended. Each delivery already includes the retries of the delivery client.
+const DefaultSuspendAfter = 10
+
+// DeliveryStats are the results of the deliveries of events to a subscription.
+type DeliveryStats struct {
+	APIFamily      string
+	SubscriptionID string
+
+	// Status is StatusActive or StatusSuspended. Events are not delivered to suspended subscriptions.
+	Status      string
+	SuspendedAt time.Time
+
+	Delivered           uint64
+	Failed              uint64
+	ConsecutiveFailures int
+
+	LastSuccess time.Time
+	LastFailure time.Time
+	LastError   string
+
+	// LastLatency is the duration of the last delivery, and TotalLatency the sum of all of them
+	LastLatency  time.Duration
+	TotalLatency time.Duration
+}
+
+// AvgLatency returns the average duration of the deliveries
+func (s DeliveryStats) AvgLatency() time.Duration {
+	n := s.Delivered + s.Failed
+	if n == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(n)
+}
+
+// healthTracker records the delivery results of each subscription
+type healthTracker struct {
+	mu           sync.RWMutex
+	stats        map[string]*DeliveryStats // apiFamily/id -> stats
+	suspendAfter int
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{
+		stats:        make(map[string]*DeliveryStats),
+		suspendAfter: DefaultSuspendAfter,
+	}
+}
+
+func healthKey(apiFamily, id string) string {
+	return apiFamily + "/" + id
+}
+
+// entry returns the stats of the subscription, creating them if needed. It must be called with the lock held.
+func (h *healthTracker) entry(sub *Subscription) *DeliveryStats {
+	key := healthKey(sub.APIFamily, sub.ID)
+	st, ok := h.stats[key]
+	if !ok {
+		st = &DeliveryStats{APIFamily: sub.APIFamily, SubscriptionID: sub.ID, Status: StatusActive}
+		h.stats[key] = st
+	}
+	return st
+}
+
+// record updates the stats of the subscription with the result of a delivery
+func (h *healthTracker) record(sub *Subscription, latency time.Duration, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st := h.entry(sub)
+	now := time.Now()
+	st.LastLatency = latency
+	st.TotalLatency += latency
+
+	if err == nil {
+		st.Delivered++
+		st.ConsecutiveFailures = 0
+		st.LastSuccess = now
+		return
+	}
+
+	st.Failed++
+	st.ConsecutiveFailures++
+	st.LastFailure = now
+	st.LastError = err.Error()
+
+	if h.suspendAfter > 0 && st.ConsecutiveFailures >= h.suspendAfter && st.Status != StatusSuspended {
+		st.Status = StatusSuspended
+		st.SuspendedAt = now
+		slog.Warn("subscription suspended after persistent delivery failures",
+			"apiFamily", sub.APIFamily, "sub.id", sub.ID, "callback", sub.Callback,
+			"consecutiveFailures", st.ConsecutiveFailures, "error", err)
+	}
+}
+
+func (h *healthTracker) suspended(sub *Subscription) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	st, ok := h.stats[healthKey(sub.APIFamily, sub.ID)]
+	return ok && st.Status == StatusSuspended
+}
+
+func (h *healthTracker) get(sub *Subscription) DeliveryStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if st, ok := h.stats[healthKey(sub.APIFamily, sub.ID)]; ok {
+		return *st
+	}
+	return DeliveryStats{APIFamily: sub.APIFamily, SubscriptionID: sub.ID, Status: StatusActive}
+}
+
+func (h *healthTracker) remove(apiFamily, id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.stats, healthKey(apiFamily, id))
+}
+
+// all returns a copy of the stats of all subscriptions, sorted by API family and identifier
+func (h *healthTracker) all() []DeliveryStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	result := make([]DeliveryStats, 0, len(h.stats))
+	for _, st := range h.stats {
+		result = append(result, *st)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].APIFamily != result[j].APIFamily {
+			return result[i].APIFamily < result[j].APIFamily
+		}
+		return result[i].SubscriptionID < result[j].SubscriptionID
+	})
+	return result
+}
+
+// SetSuspendAfter sets the number of consecutive failed deliveries after which a subscription is
+// suspended. A value of zero or less disables the automatic suspension.
+func (m *Manager) SetSuspendAfter(n int) {
+	m.health.mu.Lock()
+	defer m.health.mu.Unlock()
+	m.health.suspendAfter = n
+}
+
+// SubscriptionStats returns the delivery results of a subscription of an API family.
+func (m *Manager) SubscriptionStats(apiFamily, id string) (DeliveryStats, error) {
+	sub, err := m.store.GetSubscription(apiFamily, id)
+	if err != nil {
+		return DeliveryStats{}, err
+	}
+	return m.health.get(sub), nil
+}
+
+// ResumeSubscription reactivates a suspended subscription, resetting its consecutive failures.
+func (m *Manager) ResumeSubscription(apiFamily, id string) error {
+	sub, err := m.store.GetSubscription(apiFamily, id)
+	if err != nil {
+		return err
+	}
+	m.health.mu.Lock()
+	defer m.health.mu.Unlock()
+	st := m.health.entry(sub)
+	st.Status = StatusActive
+	st.SuspendedAt = time.Time{}
+	st.ConsecutiveFailures = 0
+	return nil
+}
+
+// deliverTracked delivers the event to the subscription and records the result
+func (m *Manager) deliverTracked(deliver DeliveryClient, sub *Subscription, payload any) {
+	start := time.Now()
+	err := deliver.Deliver(sub, payload)
+	m.health.record(sub, time.Since(start), err)
+	if err != nil {
+		slog.Warn("notification delivery failed", "sub.id", sub.ID, "callback", sub.Callback, "error", err)
+	}
+}
+
+// WriteMetrics writes the delivery metrics of all subscriptions in the Prometheus text exposition format.
+func (m *Manager) WriteMetrics(w io.Writer) error {
+	all := m.health.all()
+
+	type metric struct {
+		name, help, kind string
+		value            func(st DeliveryStats) float64
+	}
+	metrics := []metric{
+		{"isbetmf_hub_deliveries_total", "Events delivered successfully to the subscription.", "counter",
+			func(st DeliveryStats) float64 { return float64(st.Delivered) }},
+		{"isbetmf_hub_delivery_failures_total", "Events which could not be delivered to the subscription.", "counter",
+			func(st DeliveryStats) float64 { return float64(st.Failed) }},
+		{"isbetmf_hub_consecutive_failures", "Failed deliveries since the last successful one.", "gauge",
+			func(st DeliveryStats) float64 { return float64(st.ConsecutiveFailures) }},
+		{"isbetmf_hub_delivery_latency_seconds_sum", "Total time spent delivering events to the subscription.", "counter",
+			func(st DeliveryStats) float64 { return st.TotalLatency.Seconds() }},
+		{"isbetmf_hub_delivery_latency_seconds_last", "Duration of the last delivery to the subscription.", "gauge",
+			func(st DeliveryStats) float64 { return st.LastLatency.Seconds() }},
+		{"isbetmf_hub_last_success_timestamp_seconds", "Time of the last successful delivery.", "gauge",
+			func(st DeliveryStats) float64 { return unixSeconds(st.LastSuccess) }},
+		{"isbetmf_hub_last_failure_timestamp_seconds", "Time of the last failed delivery.", "gauge",
+			func(st DeliveryStats) float64 { return unixSeconds(st.LastFailure) }},
+		{"isbetmf_hub_suspended", "1 if the subscription is suspended because of persistent failures.", "gauge",
+			func(st DeliveryStats) float64 {
+				if st.Status == StatusSuspended {
+					return 1
+				}
+				return 0
+			}},
+	}
+
+	var b strings.Builder
+	for _, mt := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", mt.name, mt.help, mt.name, mt.kind)
+		for _, st := range all {
+			fmt.Fprintf(&b, "%s{api_family=%q,subscription=%q} %g\n", mt.name, st.APIFamily, st.SubscriptionID, mt.value(st))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func unixSeconds(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.UnixNano()) / 1e9
+}
@@ -0,0 +1,115 @@
+package notifications
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyDelivery fails all deliveries while failing is true
+type flakyDelivery struct {
+	mu      sync.Mutex
+	failing bool
+	calls   int
+}
+
+func (f *flakyDelivery) Deliver(_ *Subscription, _ any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.failing {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+// waitStats waits until the subscription has the expected number of deliveries
+func waitStats(t *testing.T, m *Manager, id string, attempts uint64) DeliveryStats {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		st, err := m.SubscriptionStats("TMF620", id)
+		if err != nil {
+			t.Fatalf("stats: %v", err)
+		}
+		if st.Delivered+st.Failed >= attempts || time.Now().After(deadline) {
+			return st
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSubscriptionSuspendedAfterConsecutiveFailures(t *testing.T) {
+	delivery := &flakyDelivery{}
+	m := NewManager(NewMemoryStore(), delivery)
+	m.SetSuspendAfter(3)
+
+	if _, err := m.CreateSubscription("TMF620", &Subscription{ID: "s1", Callback: "https://example.com/listener"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{})
+	st := waitStats(t, m, "s1", 1)
+	if st.Delivered != 1 || st.LastSuccess.IsZero() || st.Status != StatusActive {
+		t.Fatalf("unexpected stats after success: %+v", st)
+	}
+
+	// Deliveries are asynchronous, so wait for each one to keep the count of consecutive failures exact
+	delivery.mu.Lock()
+	delivery.failing = true
+	delivery.mu.Unlock()
+	for i := 2; i <= 4; i++ {
+		m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{})
+		st = waitStats(t, m, "s1", uint64(i))
+	}
+	if st.Failed != 3 || st.ConsecutiveFailures != 3 || st.LastError != "connection refused" {
+		t.Fatalf("unexpected stats after failures: %+v", st)
+	}
+	if st.Status != StatusSuspended || st.SuspendedAt.IsZero() {
+		t.Fatalf("expected subscription to be suspended, got %s", st.Status)
+	}
+
+	// Suspended subscriptions do not receive events
+	m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{})
+	time.Sleep(50 * time.Millisecond)
+	delivery.mu.Lock()
+	calls := delivery.calls
+	delivery.failing = false
+	delivery.mu.Unlock()
+	if calls != 4 {
+		t.Fatalf("expected 4 delivery attempts, got %d", calls)
+	}
+
+	var b strings.Builder
+	if err := m.WriteMetrics(&b); err != nil {
+		t.Fatalf("metrics: %v", err)
+	}
+	for _, line := range []string{
+		`isbetmf_hub_deliveries_total{api_family="TMF620",subscription="s1"} 1`,
+		`isbetmf_hub_delivery_failures_total{api_family="TMF620",subscription="s1"} 3`,
+		`isbetmf_hub_suspended{api_family="TMF620",subscription="s1"} 1`,
+	} {
+		if !strings.Contains(b.String(), line) {
+			t.Errorf("metrics do not contain %q:\n%s", line, b.String())
+		}
+	}
+
+	// Once resumed, events are delivered again
+	if err := m.ResumeSubscription("TMF620", "s1"); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{})
+	if st = waitStats(t, m, "s1", 5); st.Status != StatusActive || st.ConsecutiveFailures != 0 || st.Delivered != 2 {
+		t.Fatalf("unexpected stats after resume: %+v", st)
+	}
+
+	// Stats are removed with the subscription
+	if err := m.DeleteSubscription("TMF620", "s1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := m.SubscriptionStats("TMF620", "s1"); err == nil {
+		t.Fatalf("expected error for deleted subscription")
+	}
+}
@@ -0,0 +1,125 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hesusruiz/isbetmf/internal/jpath"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// amqpSink publishes the events to an exchange of an AMQP 0-9-1 broker, like RabbitMQ
+type amqpSink struct {
+	mu         sync.Mutex
+	url        string
+	exchange   string
+	routingKey string
+	timeout    time.Duration
+
+	conn   *amqp.Connection
+	ch     *amqp.Channel
+	closed bool
+}
+
+// defaultAMQPRoutingKey is used when the sink URL does not specify the 'routingKey' parameter
+const defaultAMQPRoutingKey = "{apiFamily}.{eventType}"
+
+// newAMQPSink connects to the broker in the URL. The 'exchange' query parameter is the exchange
+// where events are published (the default exchange if not specified), and 'routingKey' is the
+// template of the routing key. The connection is re-established on the next delivery if it is lost.
+func newAMQPSink(u *url.URL) (Sink, error) {
+	q := u.Query()
+	s := &amqpSink{
+		exchange:   q.Get("exchange"),
+		routingKey: q.Get("routingKey"),
+		timeout:    10 * time.Second,
+	}
+	if s.routingKey == "" {
+		s.routingKey = defaultAMQPRoutingKey
+	}
+
+	// The remaining parameters are not understood by the AMQP client
+	server := *u
+	server.RawQuery = ""
+	s.url = server.String()
+
+	if err := s.connect(); err != nil {
+		return nil, fmt.Errorf("connecting to AMQP %s: %w", u.Redacted(), err)
+	}
+	return s, nil
+}
+
+// connect opens the connection and the channel. It must be called with the lock held.
+func (s *amqpSink) connect() error {
+	conn, err := amqp.Dial(s.url)
+	if err != nil {
+		return err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	s.conn, s.ch = conn, ch
+	return nil
+}
+
+func (s *amqpSink) Deliver(sub *Subscription, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	headers := amqp.Table{}
+	for k, v := range sinkHeaders(sub, payload) {
+		headers[k] = v
+	}
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    jpath.GetString(payload, "eventId"),
+		Timestamp:    time.Now(),
+		Headers:      headers,
+		Body:         data,
+	}
+
+	// A channel must not be used concurrently for publishing
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("AMQP sink is closed")
+	}
+	if s.conn == nil || s.conn.IsClosed() {
+		if err := s.connect(); err != nil {
+			return fmt.Errorf("reconnecting to AMQP: %w", err)
+		}
+	} else if s.ch.IsClosed() {
+		// The broker closes the channel on errors, like publishing to a missing exchange
+		ch, err := s.conn.Channel()
+		if err != nil {
+			return fmt.Errorf("reopening AMQP channel: %w", err)
+		}
+		s.ch = ch
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.ch.PublishWithContext(ctx, s.exchange, expandTarget(s.routingKey, sub, payload), false, false, msg)
+}
+
+func (s *amqpSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn, s.ch = nil, nil
+	return err
+}
@@ -0,0 +1,62 @@
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileSink appends each event as a line of JSON to a local file (JSON Lines format).
+// It is useful for tests and for simple integrations where another process tails the file.
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+// NewFileSink creates a sink which appends the events to the file in path, creating it if needed.
+func NewFileSink(path string) (Sink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file sink requires a path")
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("creating directory for file sink: %w", err)
+		}
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening file sink: %w", err)
+	}
+	return &fileSink{path: path, f: f}, nil
+}
+
+func (s *fileSink) Deliver(sub *Subscription, payload any) error {
+	line, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return fmt.Errorf("file sink %s is closed", s.path)
+	}
+	// A single write per line, so concurrent readers never see partial events
+	_, err = s.f.Write(line)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
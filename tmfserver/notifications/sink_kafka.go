@@ -0,0 +1,75 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaSink publishes the events to a Kafka topic
+type kafkaSink struct {
+	writer  *kafka.Writer
+	topic   string
+	timeout time.Duration
+}
+
+// defaultKafkaTopic is used when the sink URL does not specify the 'topic' parameter
+const defaultKafkaTopic = "tmf.{apiFamily}"
+
+// newKafkaSink creates a writer to the comma-separated list of brokers in the host of the URL.
+// The 'topic' query parameter is the template of the topic where events are published.
+// Messages are keyed by the identifier of the resource, so the events of the same resource
+// are kept in order in the same partition.
+func newKafkaSink(u *url.URL) (Sink, error) {
+	var brokers []string
+	for _, b := range strings.Split(u.Host, ",") {
+		if b = strings.TrimSpace(b); b != "" {
+			brokers = append(brokers, b)
+		}
+	}
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("kafka sink requires at least one broker")
+	}
+
+	topic := u.Query().Get("topic")
+	if topic == "" {
+		topic = defaultKafkaTopic
+	}
+
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: u.Query().Get("autoCreate") == "true",
+	}
+	return &kafkaSink{writer: writer, topic: topic, timeout: 10 * time.Second}, nil
+}
+
+func (s *kafkaSink) Deliver(sub *Subscription, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	msg := kafka.Message{
+		Topic: expandTarget(s.topic, sub, payload),
+		Key:   []byte(eventKey(payload)),
+		Value: data,
+	}
+	for k, v := range sinkHeaders(sub, payload) {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.writer.WriteMessages(ctx, msg)
+}
+
+func (s *kafkaSink) Close() error {
+	return s.writer.Close()
+}
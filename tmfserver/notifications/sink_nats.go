@@ -0,0 +1,58 @@
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsSink publishes the events to a NATS subject
+type natsSink struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// defaultNATSSubject is used when the sink URL does not specify the 'subject' parameter
+const defaultNATSSubject = "tmf.{apiFamily}.{eventType}"
+
+// newNATSSink connects to the NATS server in the URL. The 'subject' query parameter is the
+// template of the subject where events are published. The client reconnects automatically
+// if the connection to the server is lost.
+func newNATSSink(u *url.URL) (Sink, error) {
+	q := u.Query()
+	subject := q.Get("subject")
+	if subject == "" {
+		subject = defaultNATSSubject
+	}
+
+	// The remaining parameters are not understood by the NATS client
+	server := *u
+	server.RawQuery = ""
+
+	conn, err := nats.Connect(server.String(), nats.Name("isbetmf"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to NATS %s: %w", u.Redacted(), err)
+	}
+	return &natsSink{conn: conn, subject: subject}, nil
+}
+
+func (s *natsSink) Deliver(sub *Subscription, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	msg := nats.NewMsg(expandTarget(s.subject, sub, payload))
+	msg.Data = data
+	msg.Header.Set("Content-Type", "application/json")
+	for k, v := range sinkHeaders(sub, payload) {
+		msg.Header.Set(k, v)
+	}
+	return s.conn.PublishMsg(msg)
+}
+
+func (s *natsSink) Close() error {
+	// Drain flushes the pending messages before closing the connection
+	return s.conn.Drain()
+}
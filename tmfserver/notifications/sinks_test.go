@@ -0,0 +1,111 @@
+package notifications
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// readLines waits until the file has n lines of JSON and returns them decoded
+func readLines(t *testing.T, path string, n int) []map[string]any {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		var lines []map[string]any
+		if f, err := os.Open(path); err == nil {
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var m map[string]any
+				if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+					t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+				}
+				lines = append(lines, m)
+			}
+			f.Close()
+		}
+		if len(lines) >= n || time.Now().After(deadline) {
+			if len(lines) != n {
+				t.Fatalf("expected %d lines in %s, got %d", n, path, len(lines))
+			}
+			return lines
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSinkSubscriptionAndGlobalSink(t *testing.T) {
+	dir := t.TempDir()
+	m := NewManager(NewMemoryStore(), nil)
+	policy := DefaultCallbackPolicy()
+	policy.SinkSubscribers = []string{"VATES-B60645900"}
+	m.SetCallbackPolicy(policy)
+	defer m.Close()
+
+	subPath := filepath.Join(dir, "sub", "events.jsonl")
+	subSink, err := NewSinkFromURL("file://" + subPath)
+	if err != nil {
+		t.Fatalf("creating file sink: %v", err)
+	}
+	if err := m.RegisterSink("platform", subSink, false); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	allPath := filepath.Join(dir, "all.jsonl")
+	allSink, err := NewFileSink(allPath)
+	if err != nil {
+		t.Fatalf("creating file sink: %v", err)
+	}
+	if err := m.RegisterSink("audit", allSink, true); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	// Sink callbacks are not subject to the rules of the URLs, but the sink must exist and
+	// only the subscribers allowed by the policy can use it
+	if _, err := m.CreateSubscription("TMF620", &Subscription{ID: "s1", Callback: "sink://platform", EventTypes: []string{"ProductOfferingCreateEvent"}, Owner: "VATES-B60645900"}); err != nil {
+		t.Fatalf("subscription to sink: %v", err)
+	}
+	_, err = m.CreateSubscription("TMF620", &Subscription{ID: "s2", Callback: "sink://unknown", Owner: "VATES-B60645900"})
+	if !errors.Is(err, &ErrInvalidCallback{}) {
+		t.Fatalf("expected ErrInvalidCallback for unknown sink, got %v", err)
+	}
+	_, err = m.CreateSubscription("TMF620", &Subscription{ID: "s3", Callback: "sink://platform", Owner: "VATES-11111111K"})
+	if !errors.Is(err, &ErrInvalidCallback{}) {
+		t.Fatalf("expected ErrInvalidCallback for subscriber not allowed, got %v", err)
+	}
+
+	m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{"eventId": "e1", "eventType": "ProductOfferingCreateEvent"})
+	m.PublishEvent("TMF620", "ProductOfferingDeleteEvent", map[string]any{"eventId": "e2", "eventType": "ProductOfferingDeleteEvent"})
+	m.PublishEvent("TMF637", "ProductCreateEvent", map[string]any{"eventId": "e3", "eventType": "ProductCreateEvent"})
+
+	if lines := readLines(t, subPath, 1); lines[0]["eventId"] != "e1" {
+		t.Fatalf("unexpected event in subscription sink: %v", lines[0])
+	}
+	readLines(t, allPath, 3)
+}
+
+func TestNewSinkFromURL(t *testing.T) {
+	if _, err := NewSinkFromURL("ftp://example.com/events"); err == nil {
+		t.Fatalf("expected error for unsupported sink type")
+	}
+	if _, err := NewSinkFromURL("kafka://?topic=x"); err == nil {
+		t.Fatalf("expected error for kafka sink without brokers")
+	}
+
+	sink, err := NewSinkFromURL("kafka://b1:9092,b2:9092?topic=tmf.{apiFamily}.{eventType}")
+	if err != nil {
+		t.Fatalf("kafka sink: %v", err)
+	}
+	defer sink.Close()
+	if ks := sink.(*kafkaSink); ks.topic != "tmf.{apiFamily}.{eventType}" {
+		t.Fatalf("unexpected topic %q", ks.topic)
+	}
+
+	sub := &Subscription{APIFamily: "TMF620"}
+	if got := expandTarget("tmf.{apiFamily}.{eventType}", sub, map[string]any{"eventType": "ProductOfferingCreateEvent"}); got != "tmf.TMF620.ProductOfferingCreateEvent" {
+		t.Fatalf("unexpected expansion %q", got)
+	}
+}
@@ -0,0 +1,135 @@
+package notifications
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hesusruiz/isbetmf/internal/jpath"
+)
+
+// listenerBufferSize is the number of events buffered for each live listener.
+// A listener which falls behind more than this is disconnected, and it is expected to
+// reconnect and resume from the event log.
+const listenerBufferSize = 64
+
+// listener is a live consumer of events, like a Server-Sent Events connection
+type listener struct {
+	filter EventFilter
+	query  string
+	ch     chan *Event
+}
+
+// listenerSet is the set of live listeners of the Manager
+type listenerSet struct {
+	mu        sync.Mutex
+	listeners map[*listener]struct{}
+}
+
+func newListenerSet() *listenerSet {
+	return &listenerSet{listeners: make(map[*listener]struct{})}
+}
+
+// Listen registers a live listener for the events matching the filter and the query.
+// It returns the channel where events are sent and a function to cancel the listener,
+// which must always be called when the caller is done.
+// The channel is closed when the listener is cancelled or when it can not keep up with
+// the rate of events.
+func (m *Manager) Listen(filter EventFilter, query string) (<-chan *Event, func()) {
+	l := &listener{filter: filter, query: query, ch: make(chan *Event, listenerBufferSize)}
+
+	m.listeners.mu.Lock()
+	m.listeners.listeners[l] = struct{}{}
+	m.listeners.mu.Unlock()
+
+	cancel := func() {
+		m.listeners.mu.Lock()
+		defer m.listeners.mu.Unlock()
+		if _, ok := m.listeners.listeners[l]; ok {
+			delete(m.listeners.listeners, l)
+			close(l.ch)
+		}
+	}
+	return l.ch, cancel
+}
+
+// broadcast sends the event to all the live listeners interested in it
+func (s *listenerSet) broadcast(ev *Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for l := range s.listeners {
+		if !l.filter.Match(ev) || !MatchQuery(l.query, ev.Payload) {
+			continue
+		}
+		select {
+		case l.ch <- ev:
+		default:
+			// The listener is too slow. Disconnect it instead of blocking the publisher.
+			delete(s.listeners, l)
+			close(l.ch)
+		}
+	}
+}
+
+// ValidateQuery checks the syntax of a subscription query.
+func ValidateQuery(query string) error {
+	_, err := parseQuery(query)
+	return err
+}
+
+// MatchQuery returns true if the payload of an event satisfies the query.
+//
+// The query has the syntax of URL query parameters, like in TMF630 filtering:
+// "eventType=ProductOfferingCreateEvent&event.resource.lifecycleStatus=Launched,Active".
+// Each key is a dotted path into the event payload, and the condition is satisfied if the value
+// at that path is equal to one of the comma-separated values. All conditions must be satisfied.
+// An empty query matches all events.
+func MatchQuery(query string, payload any) bool {
+	conditions, err := parseQuery(query)
+	if err != nil {
+		return false
+	}
+	for path, values := range conditions {
+		v, err := jpath.Get(payload, path)
+		if err != nil || v == nil {
+			return false
+		}
+		actual := fmt.Sprint(v)
+		found := false
+		for _, expected := range values {
+			if actual == expected {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// parseQuery parses a query into a map of dotted paths to accepted values
+func parseQuery(query string) (map[string][]string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+	qp, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	conditions := make(map[string][]string, len(qp))
+	for key, values := range qp {
+		if key == "" {
+			return nil, fmt.Errorf("invalid query %q: empty attribute", query)
+		}
+		for _, v := range values {
+			for _, item := range strings.Split(v, ",") {
+				conditions[key] = append(conditions[key], strings.TrimSpace(item))
+			}
+		}
+	}
+	return conditions, nil
+}
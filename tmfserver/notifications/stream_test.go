@@ -0,0 +1,72 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMatchQuery(t *testing.T) {
+	payload := map[string]any{
+		"eventType": "ProductOfferingCreateEvent",
+		"event": map[string]any{
+			"resource": map[string]any{"lifecycleStatus": "Launched", "version": nil},
+		},
+	}
+
+	testCases := []struct {
+		query string
+		match bool
+	}{
+		{"", true},
+		{"eventType=ProductOfferingCreateEvent", true},
+		{"eventType=ProductOfferingDeleteEvent", false},
+		{"event.resource.lifecycleStatus=Active,Launched", true},
+		{"eventType=ProductOfferingCreateEvent&event.resource.lifecycleStatus=Retired", false},
+		{"event.resource.missing=x", false},
+		{"event.resource.version.major=1", false},
+	}
+
+	for _, tc := range testCases {
+		if got := MatchQuery(tc.query, payload); got != tc.match {
+			t.Errorf("query %q: expected %t, got %t", tc.query, tc.match, got)
+		}
+	}
+}
+
+func TestListenReceivesMatchingEvents(t *testing.T) {
+	m := NewManager(NewMemoryStore(), nil)
+
+	live, cancel := m.Listen(EventFilter{APIFamily: "TMF620", EventTypes: []string{"ProductOfferingCreateEvent"}}, "")
+	defer cancel()
+
+	m.PublishEvent("TMF632", "ProductOfferingCreateEvent", map[string]any{"eventId": "e1"})
+	m.PublishEvent("TMF620", "ProductOfferingDeleteEvent", map[string]any{"eventId": "e2"})
+	m.PublishEvent("TMF620", "ProductOfferingCreateEvent", map[string]any{"eventId": "e3"})
+
+	select {
+	case ev := <-live:
+		if ev.ID != "e3" {
+			t.Fatalf("expected event e3, got %s", ev.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for event")
+	}
+
+	if n := len(m.Events().List(EventFilter{})); n != 3 {
+		t.Fatalf("expected 3 events in the log, got %d", n)
+	}
+}
+
+func TestEventLogIsBounded(t *testing.T) {
+	l := NewEventLog(2)
+	for _, id := range []string{"a", "b", "c"} {
+		l.Append(&Event{ID: id})
+	}
+	events := l.List(EventFilter{})
+	if len(events) != 2 || events[0].ID != "b" || events[1].Seq != 3 {
+		t.Fatalf("unexpected events in log: %v", events)
+	}
+	if l.Get("a") != nil {
+		t.Fatalf("oldest event should have been discarded")
+	}
+}
@@ -0,0 +1,95 @@
+package notifications
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	errTopicNotFound = errors.New("topic not found")
+	errTopicExists   = errors.New("topic already exists")
+)
+
+// Topic is an event channel as defined in TMF688 Event Management.
+// The identifier of a topic is the API family whose events are published on it,
+// so the subscriptions to a topic are the same as the hub subscriptions of the API family.
+type Topic struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	ContentQuery string    `json:"contentQuery,omitempty"`
+	HeaderQuery  string    `json:"headerQuery,omitempty"`
+	Owner        string    `json:"owner,omitempty"` // Organization which created it, empty for the topics of the API families
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// topicRegistry is an in-memory registry of topics
+type topicRegistry struct {
+	mu     sync.RWMutex
+	topics map[string]*Topic
+}
+
+func newTopicRegistry() *topicRegistry {
+	return &topicRegistry{topics: make(map[string]*Topic)}
+}
+
+func (r *topicRegistry) add(t *Topic) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.topics[t.ID]; ok {
+		return errTopicExists
+	}
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = time.Now()
+	}
+	r.topics[t.ID] = t
+	return nil
+}
+
+func (r *topicRegistry) get(id string) (*Topic, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.topics[id]
+	if !ok {
+		return nil, errTopicNotFound
+	}
+	return t, nil
+}
+
+func (r *topicRegistry) delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.topics[id]; !ok {
+		return errTopicNotFound
+	}
+	delete(r.topics, id)
+	return nil
+}
+
+func (r *topicRegistry) list() []*Topic {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]*Topic, 0, len(r.topics))
+	for _, t := range r.topics {
+		result = append(result, t)
+	}
+	slices.SortFunc(result, func(a, b *Topic) int { return strings.Compare(a.ID, b.ID) })
+	return result
+}
+
+// IsTopicNotFound returns true if the error was caused by a missing topic.
+func IsTopicNotFound(err error) bool {
+	return errors.Is(err, errTopicNotFound)
+}
+
+// IsTopicExists returns true if the error was caused by creating a topic which already exists.
+func IsTopicExists(err error) bool {
+	return errors.Is(err, errTopicExists)
+}
+
+// IsSubscriptionNotFound returns true if the error was caused by a missing subscription.
+func IsSubscriptionNotFound(err error) bool {
+	return errors.Is(err, errNotFound)
+}
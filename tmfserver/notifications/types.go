@@ -3,6 +3,8 @@ package notifications
 import (
 	"log/slog"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // Subscription represents a hub subscription created by a client for a given API family.
@@ -13,6 +15,7 @@ type Subscription struct {
 	EventTypes []string          `json:"eventTypes,omitempty"`
 	Headers    map[string]string `json:"headers,omitempty"`
 	Query      string            `json:"query,omitempty"`
+	Owner      string            `json:"owner,omitempty"` // Organization which created it, the only one which can manage it
 	CreatedAt  time.Time         `json:"createdAt"`
 }
 
@@ -29,19 +32,94 @@ type DeliveryClient interface {
 	Deliver(sub *Subscription, payload any) error
 }
 
+// Verifier is implemented by delivery clients able to perform a verification handshake
+// with the subscriber before the subscription is activated.
+type Verifier interface {
+	Verify(sub *Subscription) error
+}
+
 // Manager coordinates subscriptions and event publishing.
 type Manager struct {
 	store   Store
 	deliver DeliveryClient
+
+	// policy restricts the callbacks accepted. When nil, any callback is accepted.
+	policy *CallbackPolicy
+
+	// events retains the most recent events, so consumers can poll them
+	events *EventLog
+
+	// topics are the TMF688 event channels
+	topics *topicRegistry
+
+	// listeners are the live consumers of events, like Server-Sent Events connections
+	listeners *listenerSet
+
+	// sinks are the destinations configured by the operator, like message brokers
+	sinks *sinkRegistry
+
+	// health tracks the delivery results of each subscription
+	health *healthTracker
 }
 
 func NewManager(store Store, deliver DeliveryClient) *Manager {
-	return &Manager{store: store, deliver: deliver}
+	return &Manager{
+		store:     store,
+		deliver:   deliver,
+		events:    NewEventLog(DefaultEventLogSize),
+		topics:    newTopicRegistry(),
+		listeners: newListenerSet(),
+		sinks:     newSinkRegistry(),
+		health:    newHealthTracker(),
+	}
+}
+
+// SetEventLogSize replaces the event log with a new one retaining at most size events.
+// It should be called before starting to publish events.
+func (m *Manager) SetEventLogSize(size int) {
+	m.events = NewEventLog(size)
+}
+
+// Events returns the log of recent events.
+func (m *Manager) Events() *EventLog {
+	return m.events
+}
+
+// SetCallbackPolicy sets the policy used to validate the callbacks of new subscriptions.
+func (m *Manager) SetCallbackPolicy(policy *CallbackPolicy) {
+	m.policy = policy
 }
 
 // CreateSubscription adds a new subscription for an API family.
+// If the callback references a sink ("sink://<name>"), the sink must be registered and the policy must
+// allow the owner of the subscription to use it.
+// Otherwise, if a CallbackPolicy is set, the callback is validated and, if configured, verified
+// with the subscriber before storing the subscription.
 func (m *Manager) CreateSubscription(apiFamily string, sub *Subscription) (*Subscription, error) {
 	sub.APIFamily = apiFamily
+	if _, isSink := sinkName(sub.Callback); isSink {
+		if m.policy != nil {
+			if err := m.policy.CheckSinkSubscriber(sub); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := m.deliveryFor(sub); err != nil {
+			return nil, err
+		}
+	} else if m.policy != nil {
+		if err := m.policy.ValidateURL(sub.Callback); err != nil {
+			return nil, err
+		}
+		if m.policy.VerifyCallbacks {
+			verifier, ok := m.deliver.(Verifier)
+			if !ok {
+				return nil, &ErrInvalidCallback{Callback: sub.Callback, Reason: "delivery client does not support verification"}
+			}
+			if err := verifier.Verify(sub); err != nil {
+				return nil, err
+			}
+		}
+	}
 	if err := m.store.AddSubscription(sub); err != nil {
 		return nil, err
 	}
@@ -50,13 +128,70 @@ func (m *Manager) CreateSubscription(apiFamily string, sub *Subscription) (*Subs
 
 // DeleteSubscription removes a subscription.
 func (m *Manager) DeleteSubscription(apiFamily, id string) error {
-	return m.store.DeleteSubscription(apiFamily, id)
+	if err := m.store.DeleteSubscription(apiFamily, id); err != nil {
+		return err
+	}
+	m.health.remove(apiFamily, id)
+	return nil
+}
+
+// GetSubscription retrieves a subscription of an API family.
+func (m *Manager) GetSubscription(apiFamily, id string) (*Subscription, error) {
+	return m.store.GetSubscription(apiFamily, id)
+}
+
+// ListSubscriptions returns the subscriptions of an API family.
+func (m *Manager) ListSubscriptions(apiFamily string) ([]*Subscription, error) {
+	return m.store.ListSubscriptionsByAPIFamily(apiFamily)
+}
+
+// CreateTopic registers a new topic. The identifier is set to the name if not specified.
+func (m *Manager) CreateTopic(topic *Topic) (*Topic, error) {
+	if topic.ID == "" {
+		topic.ID = topic.Name
+	}
+	if err := m.topics.add(topic); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+// GetTopic retrieves a topic by its identifier.
+func (m *Manager) GetTopic(id string) (*Topic, error) {
+	return m.topics.get(id)
+}
+
+// DeleteTopic removes a topic. Subscriptions to the topic are not removed.
+func (m *Manager) DeleteTopic(id string) error {
+	return m.topics.delete(id)
+}
+
+// ListTopics returns all topics, sorted by identifier.
+func (m *Manager) ListTopics() []*Topic {
+	return m.topics.list()
 }
 
 // PublishEvent delivers the provided payload to all matching subscribers of the API family.
-// Filtering by eventType is applied if the subscription specifies EventTypes.
+// Filtering by eventType is applied if the subscription specifies EventTypes, and filtering
+// by content if the subscription specifies a Query. Suspended subscriptions are skipped.
+// The event is also recorded in the event log and sent to the live listeners and the global sinks.
 func (m *Manager) PublishEvent(apiFamily, eventType string, payload any) {
 	slog.Debug("generating event", "apiFamily", apiFamily, "eventType", eventType)
+
+	// Retain the event so it can be retrieved later by consumers polling for events
+	ev := &Event{APIFamily: apiFamily, EventType: eventType, Payload: payload}
+	if pm, ok := payload.(map[string]any); ok {
+		ev.ID, _ = pm["eventId"].(string)
+		if t, ok := pm["eventTime"].(string); ok {
+			ev.Time, _ = time.Parse(time.RFC3339Nano, t)
+		}
+	}
+	if ev.ID == "" {
+		ev.ID = uuid.NewString()
+	}
+	m.events.Append(ev)
+	m.listeners.broadcast(ev)
+
 	subs, err := m.store.ListSubscriptionsByAPIFamily(apiFamily)
 	if err != nil {
 		return
@@ -74,8 +209,34 @@ func (m *Manager) PublishEvent(apiFamily, eventType string, payload any) {
 				continue
 			}
 		}
-		// Deliver asynchronously, ignoring individual errors (logged inside client)
+		if !MatchQuery(sub.Query, payload) {
+			continue
+		}
+		if m.health.suspended(sub) {
+			slog.Debug("skipping suspended subscription", "sub.id", sub.ID)
+			continue
+		}
+		deliver, err := m.deliveryFor(sub)
+		if err != nil {
+			slog.Warn("skipping subscription", "sub.id", sub.ID, "error", err)
+			continue
+		}
+		// Deliver asynchronously, so a slow subscriber does not delay the others
 		slog.Debug("deliver even asynchronously", "sub.id", sub.ID)
-		go m.deliver.Deliver(sub, payload)
+		go m.deliverTracked(deliver, sub, payload)
+	}
+
+	// Global sinks receive all events, as if they had a subscription without filters to every API family
+	for _, name := range m.sinks.globalNames() {
+		sink, ok := m.sinks.get(name)
+		if !ok {
+			continue
+		}
+		sub := &Subscription{APIFamily: apiFamily, Callback: SinkScheme + "://" + name}
+		go func() {
+			if err := sink.Deliver(sub, payload); err != nil {
+				slog.Warn("sink delivery failed", "sink", name, "error", err)
+			}
+		}()
 	}
 }
@@ -0,0 +1,349 @@
+// Package openapi loads the TMForum OpenAPI documents implemented by the server, and uses them
+// to know which resources exist in each API family and to validate the objects received.
+package openapi
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/hesusruiz/isbetmf/internal/jpath"
+)
+
+// Spec is the OpenAPI document of one TMForum API family, like TMF620 (productCatalogManagement).
+type Spec struct {
+	// APIFamily is the name of the API in the URL paths, like "productCatalogManagement"
+	APIFamily string
+
+	// BasePath is the path prefix of the API, like "productCatalogManagement/v5"
+	BasePath string
+
+	Title    string
+	Version  string
+	FileName string
+
+	// Subset is true for the documents which are an abridged subset of the official TMForum one,
+	// marked with "x-subset: true" in their info. Valid objects may have attributes not defined in them.
+	Subset bool
+
+	// Doc is the parsed OpenAPI document
+	Doc map[string]any
+
+	// Resources are the resources exposed by the API, by name (eg. "productOffering")
+	Resources map[string]*Resource
+}
+
+// Resource is a TMForum resource exposed by an API, with the schemas of the bodies of its operations.
+// The schemas are local references into the document, like "#/components/schemas/ProductOffering_FVO".
+type Resource struct {
+	Name string
+
+	// CreateSchema is the schema of the body of POST operations, if the resource can be created
+	CreateSchema string
+
+	// UpdateSchema is the schema of the body of PATCH operations, if the resource can be updated
+	UpdateSchema string
+
+	// Schema is the schema of the resource returned by GET operations
+	Schema string
+
+	// Listable and Deletable are true if the collection can be retrieved and the objects deleted
+	Listable  bool
+	Deletable bool
+}
+
+// Registry holds the specs of the API families implemented by the server.
+type Registry struct {
+	specs map[string]*Spec
+
+	// resourceToFamily maps each resource name to the API family where it is defined
+	resourceToFamily map[string]string
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		specs:            make(map[string]*Spec),
+		resourceToFamily: make(map[string]string),
+	}
+}
+
+// LoadDir loads all the OpenAPI v3 documents (.yaml, .yml or .json) in a directory.
+// Documents in other formats, like Swagger 2.0, are skipped.
+func LoadDir(dir string) (*Registry, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading OpenAPI directory: %w", err)
+	}
+
+	r := NewRegistry()
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		spec, err := LoadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if spec == nil {
+			slog.Debug("skipping document which is not OpenAPI v3", slog.String("file", entry.Name()))
+			continue
+		}
+		if err := r.Add(spec); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// LoadFile loads an OpenAPI v3 document. It returns nil without error if the document is
+// in another format, like Swagger 2.0.
+func LoadFile(fileName string) (*Spec, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fileName, err)
+	}
+	spec, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", fileName, err)
+	}
+	if spec != nil {
+		spec.FileName = filepath.Base(fileName)
+	}
+	return spec, nil
+}
+
+// Parse parses an OpenAPI v3 document in YAML or JSON format (JSON is a subset of YAML).
+// It returns nil without error if the document is not OpenAPI v3.
+func Parse(content []byte) (*Spec, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(jpath.GetString(doc, "openapi"), "3.") {
+		return nil, nil
+	}
+
+	spec := &Spec{
+		Title:     jpath.GetString(doc, "info.title"),
+		Version:   jpath.GetString(doc, "info.version"),
+		Subset:    jpath.GetBool(doc, "info.x-subset"),
+		Doc:       doc,
+		Resources: make(map[string]*Resource),
+	}
+
+	// The server URL has the form 'https://serverRoot/productCatalogManagement/v5/'
+	serverURL := jpath.GetString(doc, "servers.0.url")
+	if serverURL == "" {
+		return nil, fmt.Errorf("servers key not found or empty")
+	}
+	basePath := serverURL
+	if i := strings.Index(basePath, "://"); i >= 0 {
+		basePath = basePath[i+3:]
+		if j := strings.Index(basePath, "/"); j >= 0 {
+			basePath = basePath[j:]
+		}
+	}
+	spec.BasePath = strings.Trim(basePath, "/")
+	spec.APIFamily, _, _ = strings.Cut(spec.BasePath, "/")
+	if spec.APIFamily == "" {
+		return nil, fmt.Errorf("server URL %q does not contain a valid API prefix", serverURL)
+	}
+
+	for thePath, item := range jpath.GetMap(doc, "paths") {
+		ops, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(strings.Trim(thePath, "/"), "/")
+		name := parts[0]
+		if name == "" || name == "hub" || name == "listener" || strings.HasPrefix(name, "{") {
+			continue
+		}
+		res := spec.Resources[name]
+		if res == nil {
+			res = &Resource{Name: name}
+			spec.Resources[name] = res
+		}
+
+		isItem := len(parts) == 2 && strings.HasPrefix(parts[1], "{")
+		if len(parts) == 1 {
+			if ref := spec.bodySchema(ops["post"], "application/json"); ref != "" {
+				res.CreateSchema = ref
+			}
+			if _, ok := ops["get"]; ok {
+				res.Listable = true
+			}
+		}
+		if isItem {
+			if ref := spec.bodySchema(ops["patch"], "application/merge-patch+json", "application/json"); ref != "" {
+				res.UpdateSchema = ref
+			}
+			if ref := spec.responseSchema(ops["get"]); ref != "" {
+				res.Schema = ref
+			}
+			if _, ok := ops["delete"]; ok {
+				res.Deletable = true
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+// bodySchema returns the reference to the schema of the request body of an operation,
+// for the first of the media types which is present
+func (s *Spec) bodySchema(op any, mediaTypes ...string) string {
+	body, err := jpath.GetMapStrict(op, "requestBody")
+	if err != nil {
+		return ""
+	}
+	return s.contentSchema(s.resolveMap(body), mediaTypes...)
+}
+
+// responseSchema returns the reference to the schema of the 200 response of an operation
+func (s *Spec) responseSchema(op any) string {
+	resp, err := jpath.GetMapStrict(op, "responses.200")
+	if err != nil {
+		return ""
+	}
+	return s.contentSchema(s.resolveMap(resp), "application/json")
+}
+
+// contentSchema returns the reference to the schema of a request or response, for the first
+// of the media types which is present
+func (s *Spec) contentSchema(obj map[string]any, mediaTypes ...string) string {
+	content, _ := obj["content"].(map[string]any)
+	for _, mt := range mediaTypes {
+		media, _ := content[mt].(map[string]any)
+		if schema, ok := media["schema"].(map[string]any); ok {
+			ref, _ := schema["$ref"].(string)
+			return ref
+		}
+	}
+	return ""
+}
+
+// resolveMap follows the $ref of an object, if it has one
+func (s *Spec) resolveMap(m map[string]any) map[string]any {
+	for i := 0; i < maxRefChain; i++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		target, err := s.Resolve(ref)
+		if err != nil {
+			return m
+		}
+		m = target
+	}
+	return m
+}
+
+// maxRefChain limits the number of chained references followed, to protect against cycles
+const maxRefChain = 16
+
+// Resolve returns the object referenced by a local JSON reference, like "#/components/schemas/Category".
+func (s *Spec) Resolve(ref string) (map[string]any, error) {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, fmt.Errorf("only local references are supported: %s", ref)
+	}
+	var current any = s.Doc
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("reference not found: %s", ref)
+		}
+		if current, ok = m[token]; !ok {
+			return nil, fmt.Errorf("reference not found: %s", ref)
+		}
+	}
+	m, ok := current.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("reference is not an object: %s", ref)
+	}
+	return m, nil
+}
+
+// Add registers a spec. It is an error to register two specs for the same API family.
+func (r *Registry) Add(spec *Spec) error {
+	if old, ok := r.specs[spec.APIFamily]; ok {
+		return fmt.Errorf("API family %s defined in %s and %s", spec.APIFamily, old.FileName, spec.FileName)
+	}
+	r.specs[spec.APIFamily] = spec
+	for name := range spec.Resources {
+		if family, ok := r.resourceToFamily[name]; ok {
+			slog.Warn("resource defined in several API families", slog.String("resource", name),
+				slog.String("family", family), slog.String("other", spec.APIFamily))
+			continue
+		}
+		r.resourceToFamily[name] = spec.APIFamily
+	}
+	return nil
+}
+
+// Spec returns the spec of an API family.
+func (r *Registry) Spec(apiFamily string) (*Spec, bool) {
+	if r == nil {
+		return nil, false
+	}
+	spec, ok := r.specs[apiFamily]
+	return spec, ok
+}
+
+// Families returns the names of the API families in the registry, sorted.
+func (r *Registry) Families() []string {
+	if r == nil {
+		return nil
+	}
+	families := make([]string, 0, len(r.specs))
+	for family := range r.specs {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	return families
+}
+
+// FamilyOf returns the API family where a resource is defined.
+func (r *Registry) FamilyOf(resourceName string) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+	family, ok := r.resourceToFamily[resourceName]
+	return family, ok
+}
+
+// Resource returns a resource of an API family.
+func (r *Registry) Resource(apiFamily, name string) (*Resource, bool) {
+	spec, ok := r.Spec(apiFamily)
+	if !ok {
+		return nil, false
+	}
+	res, ok := spec.Resources[name]
+	return res, ok
+}
+
+// Document returns the OpenAPI document with the server URL pointing to serverRoot, the scheme and host
+// of a running server (like "https://tmf.example.com"). The document of the spec is not modified.
+func (s *Spec) Document(serverRoot string) map[string]any {
+	doc := make(map[string]any, len(s.Doc))
+	for k, v := range s.Doc {
+		doc[k] = v
+	}
+	doc["servers"] = []any{
+		map[string]any{"url": strings.TrimRight(serverRoot, "/") + "/tmf-api/" + s.BasePath},
+	}
+	return doc
+}
@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"testing"
+)
+
+func loadTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+	r, err := LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+	return r
+}
+
+func TestLoadDir(t *testing.T) {
+	r := loadTestRegistry(t)
+
+	// The TMF688 document is Swagger 2.0, so it is skipped
+	families := r.Families()
+	expected := []string{
+		"agreementManagement", "partyManagement", "productCatalogManagement", "productInventory",
+		"productOrderingManagement", "resourceCatalog", "serviceCatalogManagement", "usageManagement",
+	}
+	if len(families) != len(expected) {
+		t.Fatalf("unexpected families: %v", families)
+	}
+	for i := range expected {
+		if families[i] != expected[i] {
+			t.Fatalf("unexpected families: %v", families)
+		}
+	}
+
+	// Only the documents bundled from TMForum are complete
+	for _, family := range families {
+		spec, _ := r.Spec(family)
+		official := family == "productCatalogManagement" || family == "partyManagement"
+		if spec.Subset == official {
+			t.Errorf("%s: unexpected subset %v, version %s", family, spec.Subset, spec.Version)
+		}
+	}
+
+	// Each resource belongs to a single API family
+	for resource, family := range map[string]string{
+		"productOrder":          "productOrderingManagement",
+		"cancelProductOrder":    "productOrderingManagement",
+		"product":               "productInventory",
+		"serviceSpecification":  "serviceCatalogManagement",
+		"resourceSpecification": "resourceCatalog",
+		"agreement":             "agreementManagement",
+		"usage":                 "usageManagement",
+	} {
+		if got, _ := r.FamilyOf(resource); got != family {
+			t.Errorf("%s: expected API family %s, got %s", resource, family, got)
+		}
+	}
+
+	// Cancellations of product orders can not be modified
+	if res, _ := r.Resource("productOrderingManagement", "cancelProductOrder"); res.UpdateSchema != "" || res.Deletable || !res.Listable {
+		t.Errorf("unexpected operations of cancelProductOrder: %+v", res)
+	}
+
+	res, ok := r.Resource("productCatalogManagement", "productOffering")
+	if !ok {
+		t.Fatalf("productOffering not found")
+	}
+	if res.CreateSchema != "#/components/schemas/ProductOffering_FVO" || res.UpdateSchema != "#/components/schemas/ProductOffering_MVO" {
+		t.Fatalf("unexpected schemas: %+v", res)
+	}
+	if _, ok := r.Resource("productCatalogManagement", "organization"); ok {
+		t.Fatalf("organization must not belong to productCatalogManagement")
+	}
+}
+
+func pointers(errs []ValidationError) map[string]bool {
+	result := make(map[string]bool, len(errs))
+	for _, e := range errs {
+		result[e.Pointer] = true
+	}
+	return result
+}
+
+func TestValidateCreate(t *testing.T) {
+	r := loadTestRegistry(t)
+
+	valid := map[string]any{
+		"@type":           "ProductOffering",
+		"name":            "Cloud storage",
+		"lifecycleStatus": "Launched",
+		"lastUpdate":      "2025-01-01T00:00:00Z",
+		"isBundle":        false,
+		"validFor":        map[string]any{"startDateTime": "2025-01-01T00:00:00Z"},
+		"category":        []any{map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1"}},
+		"x-custom":        "extensions are allowed",
+	}
+	if _, errs := r.ValidateCreate("productCatalogManagement", "productOffering", valid); len(errs) != 0 {
+		t.Fatalf("expected valid object, got %v", errs)
+	}
+
+	invalid := map[string]any{
+		"@type":    "ProductOffering",
+		"isBundle": "yes",
+		"validFor": map[string]any{"startDateTime": "tomorrow"},
+		"attachment": []any{
+			map[string]any{"@type": "Picture", "url": "https://example.com/a.png"},
+		},
+		"category": []any{map[string]any{"id": "urn:ngsi-ld:category:1"}},
+	}
+	schema, errs := r.ValidateCreate("productCatalogManagement", "productOffering", invalid)
+	if schema != "#/components/schemas/ProductOffering_FVO" {
+		t.Fatalf("unexpected schema %s", schema)
+	}
+	got := pointers(errs)
+	for _, p := range []string{
+		"/name",
+		"/lifecycleStatus",
+		"/isBundle",
+		"/validFor/startDateTime",
+		"/attachment/0/@type",
+		"/category/0/@type",
+	} {
+		if !got[p] {
+			t.Errorf("expected a problem at %s, got %v", p, errs)
+		}
+	}
+
+	// Resources not in the specs are not validated
+	if schema, errs := r.ValidateCreate("productCatalogManagement", "unknown", invalid); schema != "" || len(errs) != 0 {
+		t.Fatalf("unexpected validation of unknown resource: %v", errs)
+	}
+}
+
+func TestValidateDiscriminator(t *testing.T) {
+	r := loadTestRegistry(t)
+
+	// The discriminator selects the Attachment or the AttachmentRef schema
+	obj := map[string]any{
+		"@type":           "ProductOffering",
+		"name":            "Offering",
+		"lifecycleStatus": "Launched",
+		"lastUpdate":      "2025-01-01T00:00:00Z",
+		"attachment": []any{
+			map[string]any{"@type": "AttachmentRef", "id": "a1"},
+			map[string]any{"@type": "Attachment", "attachmentType": "picture", "mimeType": "image/png", "size": map[string]any{"amount": "big"}},
+		},
+	}
+	_, errs := r.ValidateCreate("productCatalogManagement", "productOffering", obj)
+	if len(errs) != 1 || errs[0].Pointer != "/attachment/1/size/amount" {
+		t.Fatalf("expected only a problem in the size of the attachment, got %v", errs)
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	r := loadTestRegistry(t)
+
+	// Missing properties are not required in a merge patch, and null removes a property
+	patch := map[string]any{"@type": "ProductOffering", "description": nil, "lifecycleStatus": "Retired"}
+	if _, errs := r.ValidateUpdate("productCatalogManagement", "productOffering", patch); len(errs) != 0 {
+		t.Fatalf("expected valid patch, got %v", errs)
+	}
+
+	// But arrays are replaced as a whole, so their items must be complete
+	patch = map[string]any{"@type": "ProductOffering", "category": []any{map[string]any{"id": "c1"}}, "isSellable": nil}
+	_, errs := r.ValidateUpdate("productCatalogManagement", "productOffering", patch)
+	if len(errs) != 1 || errs[0].Pointer != "/category/0/@type" {
+		t.Fatalf("expected a problem in the category item, got %v", errs)
+	}
+}
@@ -0,0 +1,539 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ValidationError is a problem found in an object, at the location given by a JSON pointer (RFC 6901).
+type ValidationError struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	pointer := e.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return pointer + ": " + e.Message
+}
+
+// Mode selects what happens when an object does not conform to its schema.
+type Mode string
+
+const (
+	// ModeOff disables the validation
+	ModeOff Mode = "off"
+	// ModeWarn logs the problems but accepts the object
+	ModeWarn Mode = "warn"
+	// ModeEnforce rejects the object
+	ModeEnforce Mode = "enforce"
+)
+
+// ParseMode parses a validation mode, accepting the empty string as ModeEnforce.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(strings.ToLower(strings.TrimSpace(s))) {
+	case "", ModeEnforce:
+		return ModeEnforce, nil
+	case ModeWarn:
+		return ModeWarn, nil
+	case ModeOff:
+		return ModeOff, nil
+	default:
+		return "", fmt.Errorf("invalid validation mode %q, expected one of off, warn or enforce", s)
+	}
+}
+
+// maxDepth limits the nesting of schemas followed during validation, to protect against cyclic schemas
+const maxDepth = 64
+
+// ValidateCreate validates the body of a POST operation on a resource. It returns the schema used
+// and the problems found, which are empty if the object is valid. If the resource does not define
+// the schema of the operation, no validation is done and the schema returned is empty.
+func (r *Registry) ValidateCreate(apiFamily, resource string, obj any) (string, []ValidationError) {
+	res, ok := r.Resource(apiFamily, resource)
+	if !ok || res.CreateSchema == "" {
+		return "", nil
+	}
+	spec, _ := r.Spec(apiFamily)
+	return res.CreateSchema, spec.Validate(res.CreateSchema, obj, false)
+}
+
+// ValidateUpdate validates the body of a PATCH operation on a resource, which is a JSON Merge Patch (RFC 7396).
+// Properties which are not in the patch are not required, and null values (which remove a property)
+// are accepted, except inside arrays, which are replaced as a whole.
+func (r *Registry) ValidateUpdate(apiFamily, resource string, patch any) (string, []ValidationError) {
+	res, ok := r.Resource(apiFamily, resource)
+	if !ok || res.UpdateSchema == "" {
+		return "", nil
+	}
+	spec, _ := r.Spec(apiFamily)
+	return res.UpdateSchema, spec.Validate(res.UpdateSchema, patch, true)
+}
+
+// Validate validates a value against the schema referenced by ref, like "#/components/schemas/Category_FVO".
+// If partial is true, the value is a JSON Merge Patch, as described in ValidateUpdate.
+// The problems are sorted by their location.
+func (s *Spec) Validate(ref string, value any, partial bool) []ValidationError {
+	v := &validator{spec: s}
+	v.validate(map[string]any{"$ref": ref}, value, "", state{partial: partial})
+	sort.SliceStable(v.errs, func(i, j int) bool { return v.errs[i].Pointer < v.errs[j].Pointer })
+
+	// The same problem may be found through several members of an allOf
+	errs := v.errs[:0]
+	for i, e := range v.errs {
+		if i == 0 || e != v.errs[i-1] {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+// state is the context in which a value is validated
+type state struct {
+	// partial is true when validating a merge patch, outside of arrays
+	partial bool
+
+	// dispatched is the schema selected with a discriminator for the current value, so the
+	// discriminator is not applied again when the selected schema includes the base one with allOf
+	dispatched string
+
+	depth int
+}
+
+type validator struct {
+	spec *Spec
+	errs []ValidationError
+}
+
+func (v *validator) fail(pointer, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// validate checks the value against the schema, recording the problems found
+func (v *validator) validate(schema map[string]any, value any, ptr string, st state) {
+	if st.depth > maxDepth {
+		return
+	}
+	st.depth++
+
+	if ref, ok := schema["$ref"].(string); ok {
+		target, err := v.spec.Resolve(ref)
+		if err != nil {
+			v.fail(ptr, "invalid schema: %v", err)
+			return
+		}
+		v.validate(target, value, ptr, st)
+		return
+	}
+
+	if value == nil {
+		if st.partial || schema["nullable"] == true {
+			return
+		}
+		// Composite schemas report the null value through their members
+		if _, ok := schema["type"]; ok {
+			v.fail(ptr, "must not be null")
+			return
+		}
+	}
+
+	// Polymorphism: the discriminator selects the schema of the actual subtype of the object
+	if v.discriminate(schema, value, ptr, st) {
+		return
+	}
+
+	for _, sub := range schemaList(schema["allOf"]) {
+		v.validate(sub, value, ptr, st)
+	}
+
+	if alternatives := schemaList(schema["oneOf"]); len(alternatives) > 0 {
+		if n := v.countMatches(alternatives, value, ptr, st); n != 1 {
+			if n == 0 {
+				v.fail(ptr, "does not match any of the allowed schemas")
+			} else {
+				v.fail(ptr, "matches %d of the allowed schemas, but must match exactly one", n)
+			}
+		}
+	}
+	if alternatives := schemaList(schema["anyOf"]); len(alternatives) > 0 {
+		if v.countMatches(alternatives, value, ptr, st) == 0 {
+			v.fail(ptr, "does not match any of the allowed schemas")
+		}
+	}
+
+	if value == nil {
+		return
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && !inEnum(enum, value) {
+		v.fail(ptr, "must be one of %s", formatEnum(enum))
+	}
+
+	typ, _ := schema["type"].(string)
+	switch typ {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			v.fail(ptr, "must be an object, got %s", jsonType(value))
+			return
+		}
+		v.validateObject(schema, obj, ptr, st)
+	case "array":
+		list, ok := value.([]any)
+		if !ok {
+			v.fail(ptr, "must be an array, got %s", jsonType(value))
+			return
+		}
+		v.validateArray(schema, list, ptr, st)
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			v.fail(ptr, "must be a string, got %s", jsonType(value))
+			return
+		}
+		v.validateString(schema, str, ptr)
+	case "number", "integer":
+		num, ok := toFloat(value)
+		if !ok {
+			v.fail(ptr, "must be a %s, got %s", typ, jsonType(value))
+			return
+		}
+		if typ == "integer" && num != math.Trunc(num) {
+			v.fail(ptr, "must be an integer")
+			return
+		}
+		v.validateNumber(schema, num, ptr)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(ptr, "must be a boolean, got %s", jsonType(value))
+		}
+	default:
+		// Schemas without type, like the ones only with allOf, may still describe object properties
+		if obj, ok := value.(map[string]any); ok {
+			if _, hasProps := schema["properties"]; hasProps {
+				v.validateObject(schema, obj, ptr, st)
+			} else if _, hasReq := schema["required"]; hasReq {
+				v.validateObject(schema, obj, ptr, st)
+			}
+		}
+	}
+}
+
+// discriminate applies the discriminator of the schema, if any. It returns true if the value
+// was validated against the schema selected by the discriminator.
+func (v *validator) discriminate(schema map[string]any, value any, ptr string, st state) bool {
+	disc, ok := schema["discriminator"].(map[string]any)
+	if !ok {
+		return false
+	}
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	propertyName, _ := disc["propertyName"].(string)
+	typeName, ok := obj[propertyName].(string)
+	if !ok {
+		// The missing property is reported by the 'required' constraint
+		return false
+	}
+
+	mapping, _ := disc["mapping"].(map[string]any)
+	target, _ := mapping[typeName].(string)
+	if target == "" {
+		// With oneOf the subtype must be one of the alternatives. Otherwise, the object is a subtype
+		// not described in the spec (eg. extended with @schemaLocation), and it must satisfy the base schema.
+		if _, isOneOf := schema["oneOf"]; isOneOf && len(mapping) > 0 {
+			v.fail(ptr+"/"+escapePointer(propertyName), "unknown %s %q, must be one of %s", propertyName, typeName, formatKeys(mapping))
+			return true
+		}
+		return false
+	}
+	if target == st.dispatched {
+		return false
+	}
+
+	st.dispatched = target
+	v.validate(map[string]any{"$ref": target}, value, ptr, st)
+	return true
+}
+
+// countMatches returns how many of the alternative schemas accept the value
+func (v *validator) countMatches(alternatives []map[string]any, value any, ptr string, st state) int {
+	n := 0
+	for _, alt := range alternatives {
+		sub := &validator{spec: v.spec}
+		sub.validate(alt, value, ptr, st)
+		if len(sub.errs) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (v *validator) validateObject(schema map[string]any, obj map[string]any, ptr string, st state) {
+	properties, _ := schema["properties"].(map[string]any)
+
+	if !st.partial {
+		for _, name := range stringList(schema["required"]) {
+			if _, ok := obj[name]; !ok {
+				v.fail(ptr+"/"+escapePointer(name), "is required")
+			}
+		}
+	}
+
+	if n, ok := toFloat(schema["minProperties"]); ok && float64(len(obj)) < n {
+		v.fail(ptr, "must have at least %v properties", n)
+	}
+	if n, ok := toFloat(schema["maxProperties"]); ok && float64(len(obj)) > n {
+		v.fail(ptr, "must have at most %v properties", n)
+	}
+
+	// Iterate in order, to have a deterministic result
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// The discriminator is only applied to the object itself, not to its properties
+	childState := st
+	childState.dispatched = ""
+
+	for _, name := range names {
+		childPtr := ptr + "/" + escapePointer(name)
+		if propSchema, ok := properties[name].(map[string]any); ok {
+			if propSchema["readOnly"] == true && !st.partial {
+				// Read-only properties are set by the server, but clients usually send back what they read
+				continue
+			}
+			v.validate(propSchema, obj[name], childPtr, childState)
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				v.fail(childPtr, "is not allowed")
+			}
+		case map[string]any:
+			v.validate(additional, obj[name], childPtr, childState)
+		}
+	}
+}
+
+func (v *validator) validateArray(schema map[string]any, list []any, ptr string, st state) {
+	if n, ok := toFloat(schema["minItems"]); ok && float64(len(list)) < n {
+		v.fail(ptr, "must have at least %v items", n)
+	}
+	if n, ok := toFloat(schema["maxItems"]); ok && float64(len(list)) > n {
+		v.fail(ptr, "must have at most %v items", n)
+	}
+	if schema["uniqueItems"] == true {
+		seen := make(map[string]bool, len(list))
+		for i, item := range list {
+			key, _ := json.Marshal(item)
+			if seen[string(key)] {
+				v.fail(ptr+"/"+strconv.Itoa(i), "duplicate item")
+			}
+			seen[string(key)] = true
+		}
+	}
+
+	items, ok := schema["items"].(map[string]any)
+	if !ok {
+		return
+	}
+	// Arrays are replaced as a whole by a merge patch, so their items must be complete
+	itemState := state{depth: st.depth}
+	for i, item := range list {
+		v.validate(items, item, ptr+"/"+strconv.Itoa(i), itemState)
+	}
+}
+
+func (v *validator) validateString(schema map[string]any, str string, ptr string) {
+	length := float64(len([]rune(str)))
+	if n, ok := toFloat(schema["minLength"]); ok && length < n {
+		v.fail(ptr, "must have at least %v characters", n)
+	}
+	if n, ok := toFloat(schema["maxLength"]); ok && length > n {
+		v.fail(ptr, "must have at most %v characters", n)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := compilePattern(pattern)
+		if err == nil && !re.MatchString(str) {
+			v.fail(ptr, "must match the pattern %q", pattern)
+		}
+	}
+
+	format, _ := schema["format"].(string)
+	switch format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+			v.fail(ptr, "must be a date-time in RFC 3339 format")
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, str); err != nil {
+			v.fail(ptr, "must be a date in YYYY-MM-DD format")
+		}
+	case "uri", "url":
+		if _, err := url.Parse(str); err != nil {
+			v.fail(ptr, "must be a valid URI")
+		}
+	case "email":
+		if _, err := mail.ParseAddress(str); err != nil {
+			v.fail(ptr, "must be a valid email address")
+		}
+	case "uuid":
+		if _, err := uuid.Parse(str); err != nil {
+			v.fail(ptr, "must be a valid UUID")
+		}
+	}
+}
+
+func (v *validator) validateNumber(schema map[string]any, num float64, ptr string) {
+	if min, ok := toFloat(schema["minimum"]); ok {
+		if schema["exclusiveMinimum"] == true && num <= min {
+			v.fail(ptr, "must be greater than %v", min)
+		} else if num < min {
+			v.fail(ptr, "must be greater than or equal to %v", min)
+		}
+	}
+	if max, ok := toFloat(schema["maximum"]); ok {
+		if schema["exclusiveMaximum"] == true && num >= max {
+			v.fail(ptr, "must be less than %v", max)
+		} else if num > max {
+			v.fail(ptr, "must be less than or equal to %v", max)
+		}
+	}
+	if m, ok := toFloat(schema["multipleOf"]); ok && m > 0 {
+		if q := num / m; q != math.Trunc(q) {
+			v.fail(ptr, "must be a multiple of %v", m)
+		}
+	}
+}
+
+var (
+	patternCacheMu sync.Mutex
+	patternCache   = map[string]*regexp.Regexp{}
+)
+
+// compilePattern compiles a regular expression of a schema, caching the result
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	patternCacheMu.Lock()
+	defer patternCacheMu.Unlock()
+	if re, ok := patternCache[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patternCache[pattern] = re
+	return re, nil
+}
+
+// escapePointer escapes a property name to be used as a token of a JSON pointer
+func escapePointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
+
+func schemaList(v any) []map[string]any {
+	list, _ := v.([]any)
+	result := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		if m, ok := item.(map[string]any); ok {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func stringList(v any) []string {
+	list, _ := v.([]any)
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// toFloat converts the numbers decoded from JSON or YAML documents
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	default:
+		if _, ok := toFloat(v); ok {
+			return "number"
+		}
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func inEnum(enum []any, value any) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) && jsonType(e) == jsonType(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(enum []any) string {
+	items := make([]string, len(enum))
+	for i, e := range enum {
+		items[i] = fmt.Sprintf("%q", fmt.Sprint(e))
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+func formatKeys(m map[string]any) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, fmt.Sprintf("%q", k))
+	}
+	sort.Strings(keys)
+	return "[" + strings.Join(keys, ", ") + "]"
+}
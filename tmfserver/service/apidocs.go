@@ -0,0 +1,47 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+)
+
+// APIDescription describes an API family implemented by the server, with the URL of its OpenAPI document.
+// The name and url fields are the ones expected by Swagger UI in its list of specs.
+type APIDescription struct {
+	APIFamily string `json:"apiFamily"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	URL       string `json:"url"`
+}
+
+// openAPIDocumentPath returns the path where the OpenAPI document of an API family is served
+func openAPIDocumentPath(apiFamily string) string {
+	return "/tmf-api/" + apiFamily + "/v5/openapi.json"
+}
+
+// ListAPIs returns the API families implemented by the server, sorted by name.
+func (svc *Service) ListAPIs() *Response {
+	apis := []APIDescription{}
+	for _, family := range svc.specs.Families() {
+		spec, _ := svc.specs.Spec(family)
+		apis = append(apis, APIDescription{
+			APIFamily: family,
+			Name:      spec.Title,
+			Version:   spec.Version,
+			URL:       openAPIDocumentPath(family),
+		})
+	}
+	return &Response{StatusCode: http.StatusOK, Body: apis}
+}
+
+// GetOpenAPIDocument returns the OpenAPI document of an API family, with the server URL rewritten
+// so clients and Swagger UI send the requests to this server. serverRoot is the scheme and host
+// used by the client to reach the server, like "https://tmf.example.com".
+func (svc *Service) GetOpenAPIDocument(apiFamily, serverRoot string) *Response {
+	spec, ok := svc.specs.Spec(apiFamily)
+	if !ok {
+		return notFoundResponse(errl.Errorf("no OpenAPI document for API family: %s", apiFamily))
+	}
+	return &Response{StatusCode: http.StatusOK, Body: spec.Document(serverRoot)}
+}
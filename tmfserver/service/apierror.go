@@ -12,6 +12,16 @@ type ApiError struct {
 	Message        string `json:"message,omitempty"`
 	Status         string `json:"status,omitempty"`
 	ReferenceError string `json:"referenceError,omitempty"`
+
+	// Details lists the individual problems found in the request, like the invalid properties of the body
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+// ErrorDetail is an individual problem found in a request. Pointer is the JSON pointer (RFC 6901)
+// to the offending value in the request body, if the problem is in the body.
+type ErrorDetail struct {
+	Pointer string `json:"pointer,omitempty"`
+	Message string `json:"message"`
 }
 
 // NewApiError creates a new ApiError instance.
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxBulkOperations limits the number of operations of a bulk request
+const maxBulkOperations = 10000
+
+// BulkResult is the result of an operation of a bulk request. Index is the position of the operation in
+// the request, starting at 0, and StatusCode and Error are what the single operation would have returned.
+type BulkResult struct {
+	Index        int       `json:"index"`
+	Action       string    `json:"action"`
+	ResourceName string    `json:"resourceName,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	StatusCode   int       `json:"statusCode"`
+	Error        *ApiError `json:"error,omitempty"`
+}
+
+// BulkReport is the response to a bulk request. If the request ran in a transaction and any operation
+// failed, all the operations were rolled back.
+type BulkReport struct {
+	Succeeded  int          `json:"succeeded"`
+	Failed     int          `json:"failed"`
+	RolledBack bool         `json:"rolledBack,omitempty"`
+	Results    []BulkResult `json:"results"`
+}
+
+// pendingEvent is an event of an operation in a transaction, which is published when it is committed
+type pendingEvent struct {
+	apiFamily string
+	eventType string
+	payload   any
+}
+
+// publishEvent publishes the event of an operation, or keeps it until the transaction of the operation
+// is committed
+func (svc *Service) publishEvent(apiFamily, eventType string, payload any) {
+	if svc.pendingEvents != nil {
+		*svc.pendingEvents = append(*svc.pendingEvents, pendingEvent{apiFamily, eventType, payload})
+		return
+	}
+	svc.notif.PublishEvent(apiFamily, eventType, payload)
+}
+
+// BulkOperations runs a list of operations on the resources of an API family. The body is a JSON array or
+// a stream of JSON objects (NDJSON), each one an operation like:
+//
+//	{"action": "CREATE", "resourceName": "productOffering", "body": {...}}
+//	{"action": "UPDATE", "resourceName": "productOffering", "id": "...", "body": {...}}
+//	{"action": "DELETE", "resourceName": "productOffering", "id": "..."}
+//
+// The resourceName can be omitted if the body has a @type. Each operation is done exactly like the single
+// operation, with the same validation, seller info and authorization by the PDP.
+// With the query parameter transaction=true, the operations run in a single SQLite transaction, which is
+// rolled back if any of them fails, and the request is answered with 400. Otherwise the operations are
+// independent and the request is answered with 200. In both cases the body reports the result of each one.
+func (svc *Service) BulkOperations(req *Request) *Response {
+	slog.Debug("BulkOperations called", slog.String("apiFamily", req.APIfamily))
+
+	// The token is needed by the operations, before it is replaced for testing by extractCallerInfo
+	accessToken := req.AccessToken
+
+	// Authentication: process the AccessToken to extract caller info from its claims in the payload
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+
+	// This operation can not be done without authentication
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	var operations []map[string]any
+	err = decodeObjects(bytes.NewReader(req.Body), func(op map[string]any) {
+		operations = append(operations, op)
+	})
+	if err != nil {
+		return badRequestResponse(errl.Errorf("failed to bind request body: %w", err))
+	}
+	if len(operations) > maxBulkOperations {
+		return badRequestResponse(errl.Errorf("too many operations: %d, the maximum is %d", len(operations), maxBulkOperations))
+	}
+
+	// In a transaction, the operations are done by a copy of the service using the transaction
+	transaction := req.QueryParams.Get("transaction") == "true"
+	exec := svc
+	var tx *sqlx.Tx
+	var events []pendingEvent
+	if transaction {
+		db, ok := svc.db.(*sqlx.DB)
+		if !ok || svc.storage != nil {
+			return badRequestResponse(errl.Errorf("transactions are only supported by the built-in SQLite storage"))
+		}
+		if tx, err = db.Beginx(); err != nil {
+			return internalErrorResponse(errl.Errorf("failed to begin transaction: %w", err))
+		}
+		defer tx.Rollback()
+
+		txSvc := *svc
+		txSvc.db = tx
+		txSvc.pendingEvents = &events
+		exec = &txSvc
+	}
+
+	report := &BulkReport{Results: make([]BulkResult, 0, len(operations))}
+	for i, op := range operations {
+		result := exec.bulkOperation(req.APIfamily, accessToken, op)
+		result.Index = i
+		if result.StatusCode >= 300 {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	if transaction {
+		if report.Failed > 0 {
+			report.RolledBack = true
+			slog.Info("Bulk operations rolled back", slog.Int("failed", report.Failed), slog.Int("operations", len(operations)))
+			return &Response{StatusCode: http.StatusBadRequest, Body: report}
+		}
+		if err := tx.Commit(); err != nil {
+			return internalErrorResponse(errl.Errorf("failed to commit transaction: %w", err))
+		}
+		for _, ev := range events {
+			svc.notif.PublishEvent(ev.apiFamily, ev.eventType, ev.payload)
+		}
+	}
+
+	slog.Info("Bulk operations done", slog.Int("succeeded", report.Succeeded), slog.Int("failed", report.Failed))
+	return &Response{StatusCode: http.StatusOK, Body: report}
+}
+
+// bulkOperation runs an operation of a bulk request with the permissions of the caller
+func (svc *Service) bulkOperation(apiFamily, accessToken string, op map[string]any) BulkResult {
+	action, _ := op["action"].(string)
+	action = strings.ToUpper(action)
+	resourceName, _ := op["resourceName"].(string)
+	id, _ := op["id"].(string)
+	body, _ := op["body"].(map[string]any)
+	if resourceName == "" {
+		resourceName = resourceOfType(body)
+	}
+
+	result := BulkResult{Action: action, ResourceName: resourceName, ID: id}
+	fail := func(resp *Response) BulkResult {
+		result.StatusCode = resp.StatusCode
+		result.Error, _ = resp.Body.(*ApiError)
+		return result
+	}
+
+	if resourceName == "" {
+		return fail(badRequestResponse(errl.Errorf("resourceName is required")))
+	}
+	if resp := svc.CheckRoute(apiFamily, resourceName); resp != nil {
+		return fail(resp)
+	}
+	if IsJobResource(resourceName) {
+		return fail(badRequestResponse(errl.Errorf("jobs can not be managed with bulk operations")))
+	}
+	if action != "CREATE" && id == "" {
+		return fail(badRequestResponse(errl.Errorf("id is required for %s", action)))
+	}
+
+	req := &Request{
+		APIfamily:    apiFamily,
+		ResourceName: resourceName,
+		ID:           id,
+		QueryParams:  url.Values{},
+		AccessToken:  accessToken,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fail(badRequestResponse(errl.Errorf("invalid body: %w", err)))
+		}
+		req.Body = b
+	}
+
+	var resp *Response
+	switch action {
+	case "CREATE":
+		req.Method = http.MethodPost
+		req.Action = action
+		resp = svc.CreateGenericObject(req)
+	case "UPDATE":
+		req.Method = http.MethodPatch
+		req.Action = action
+		resp = svc.UpdateGenericObject(req)
+	case "DELETE":
+		req.Method = http.MethodDelete
+		req.Action = action
+		resp = svc.DeleteGenericObject(req)
+	default:
+		return fail(badRequestResponse(errl.Errorf("invalid action %q, expected CREATE, UPDATE or DELETE", action)))
+	}
+
+	if resp.StatusCode >= 300 {
+		return fail(resp)
+	}
+	result.StatusCode = resp.StatusCode
+	if obj, ok := resp.Body.(map[string]any); ok {
+		result.ID, _ = obj["id"].(string)
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBulkOperations(t *testing.T) {
+	s := newTestService(t)
+
+	// Independent operations: the failures do not affect the rest
+	body := `
+{"action": "CREATE", "body": {"@type": "ProductOffering", "id": "urn:ngsi-ld:product-offering:1", "name": "One"}}
+{"action": "CREATE", "resourceName": "productOffering", "body": {"id": "urn:ngsi-ld:product-offering:2", "name": "Two"}}
+{"action": "UPDATE", "resourceName": "productOffering", "id": "urn:ngsi-ld:product-offering:1", "body": {"version": "1.1", "name": "First"}}
+{"action": "DELETE", "resourceName": "productOffering", "id": "urn:ngsi-ld:product-offering:2"}
+{"action": "CREATE", "body": {"@type": "Organization", "name": "ACME"}}
+{"action": "DELETE", "resourceName": "productOffering"}
+`
+	resp := s.BulkOperations(newReq("POST", "CREATE", "productCatalogManagement", "", "", []byte(body), url.Values{}))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("bulk: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	report := resp.Body.(*BulkReport)
+	if report.Succeeded != 4 || report.Failed != 2 || report.RolledBack {
+		t.Fatalf("bulk: unexpected report %+v", report)
+	}
+	expected := []int{http.StatusCreated, http.StatusCreated, http.StatusOK, http.StatusNoContent, http.StatusNotFound, http.StatusBadRequest}
+	for i, result := range report.Results {
+		if result.Index != i || result.StatusCode != expected[i] {
+			t.Fatalf("bulk: unexpected result %d: %+v", i, result)
+		}
+	}
+	if report.Results[4].Error == nil {
+		t.Fatalf("bulk: failed operation without error")
+	}
+
+	resp = s.GetGenericObject(newReq("GET", "READ", "productCatalogManagement", "productOffering", "urn:ngsi-ld:product-offering:1", nil, nil))
+	if resp.StatusCode != http.StatusOK || resp.Body.(map[string]any)["name"] != "First" {
+		t.Fatalf("bulk: object not updated: %+v", resp.Body)
+	}
+
+	// In a transaction, a failure rolls back all the operations
+	body = `[
+		{"action": "CREATE", "resourceName": "category", "body": {"id": "urn:ngsi-ld:category:1", "name": "Storage"}},
+		{"action": "UPDATE", "resourceName": "productOffering", "id": "urn:ngsi-ld:product-offering:1", "body": {"version": "1.0"}}
+	]`
+	resp = s.BulkOperations(newReq("POST", "CREATE", "productCatalogManagement", "", "", []byte(body), url.Values{"transaction": {"true"}}))
+	if report := resp.Body.(*BulkReport); resp.StatusCode != http.StatusBadRequest || !report.RolledBack || report.Failed != 1 {
+		t.Fatalf("transaction: expected rollback, got %d %+v", resp.StatusCode, resp.Body)
+	}
+	resp = s.GetGenericObject(newReq("GET", "READ", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil))
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("transaction: expected object rolled back, got %d", resp.StatusCode)
+	}
+
+	// And it is committed when all succeed
+	body = `[{"action": "CREATE", "resourceName": "category", "body": {"id": "urn:ngsi-ld:category:1", "name": "Storage"}}]`
+	resp = s.BulkOperations(newReq("POST", "CREATE", "productCatalogManagement", "", "", []byte(body), url.Values{"transaction": {"true"}}))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("transaction: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	resp = s.GetGenericObject(newReq("GET", "READ", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("transaction: expected object committed, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
+)
+
+// conformanceCase is a resource of one of the bundled APIs, with an object which conforms to its schema
+// and another which does not
+type conformanceCase struct {
+	apiFamily    string
+	resourceName string
+	valid        map[string]any
+	invalid      map[string]any
+
+	// pointer is where the problem of the invalid object must be reported
+	pointer string
+}
+
+var conformanceCases = []conformanceCase{
+	{
+		apiFamily:    "productOrderingManagement",
+		resourceName: "productOrder",
+		valid: map[string]any{
+			"@type": "ProductOrder",
+			"state": "acknowledged",
+			"productOrderItem": []any{map[string]any{
+				"@type":           "ProductOrderItem",
+				"id":              "1",
+				"action":          "add",
+				"quantity":        1,
+				"productOffering": map[string]any{"@type": "ProductOfferingRef", "id": "urn:ngsi-ld:product-offering:1"},
+			}},
+		},
+		invalid: map[string]any{"@type": "ProductOrder", "state": "acknowledged"},
+		pointer: "/productOrderItem",
+	},
+	{
+		apiFamily:    "productInventory",
+		resourceName: "product",
+		valid: map[string]any{
+			"@type":           "Product",
+			"name":            "Cloud storage",
+			"status":          "active",
+			"isBundle":        false,
+			"startDate":       "2025-01-01T00:00:00Z",
+			"productOffering": map[string]any{"@type": "ProductOfferingRef", "id": "urn:ngsi-ld:product-offering:1"},
+		},
+		invalid: map[string]any{"@type": "Product", "name": "Cloud storage", "status": "running"},
+		pointer: "/status",
+	},
+	{
+		apiFamily:    "serviceCatalogManagement",
+		resourceName: "serviceSpecification",
+		valid: map[string]any{
+			"@type":           "ServiceSpecification",
+			"name":            "VPN",
+			"lifecycleStatus": "Active",
+			"specCharacteristic": []any{map[string]any{
+				"@type": "CharacteristicSpecification", "name": "bandwidth", "valueType": "string",
+			}},
+		},
+		invalid: map[string]any{"@type": "ServiceSpecification", "lifecycleStatus": "Active"},
+		pointer: "/name",
+	},
+	{
+		apiFamily:    "resourceCatalog",
+		resourceName: "resourceSpecification",
+		valid: map[string]any{
+			"@type":           "ResourceSpecification",
+			"name":            "Virtual machine",
+			"category":        "Compute",
+			"lifecycleStatus": "Active",
+		},
+		invalid: map[string]any{"@type": "ResourceSpecification", "name": "Virtual machine", "isBundle": "no"},
+		pointer: "/isBundle",
+	},
+	{
+		apiFamily:    "agreementManagement",
+		resourceName: "agreement",
+		valid: map[string]any{
+			"@type":         "Agreement",
+			"name":          "Partnership",
+			"agreementType": "commercial",
+			"engagedParty": []any{map[string]any{
+				"@type": "PartyRef", "id": "urn:ngsi-ld:organization:1", "@referredType": "Organization",
+			}},
+		},
+		invalid: map[string]any{"@type": "Agreement", "name": "Partnership", "agreementType": "commercial"},
+		pointer: "/engagedParty",
+	},
+	{
+		apiFamily:    "usageManagement",
+		resourceName: "usage",
+		valid: map[string]any{
+			"@type":     "Usage",
+			"usageDate": "2025-01-01T00:00:00Z",
+			"usageType": "storage",
+			"status":    "received",
+			"usageCharacteristic": []any{map[string]any{
+				"@type": "Characteristic", "name": "volume", "valueType": "number",
+			}},
+		},
+		invalid: map[string]any{"@type": "Usage", "usageDate": "yesterday"},
+		pointer: "/usageDate",
+	},
+}
+
+// TestConformance checks that the resources of the bundled APIs are routed and served by the generic
+// handlers, and that their objects are validated against the schemas of the specs.
+func TestConformance(t *testing.T) {
+	specs, err := openapi.LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+
+	for _, tc := range conformanceCases {
+		t.Run(tc.apiFamily, func(t *testing.T) {
+			s := newTestService(t)
+			s.SetOpenAPI(specs, openapi.ModeEnforce)
+
+			if resp := s.CheckRoute(tc.apiFamily, tc.resourceName); resp != nil {
+				t.Fatalf("route rejected: %d", resp.StatusCode)
+			}
+
+			// The problems of the objects which do not conform to the schema are found, but the specs of these
+			// APIs are a subset of the official ones, so the objects are not rejected even in enforce mode
+			if _, problems := specs.ValidateCreate(tc.apiFamily, tc.resourceName, tc.invalid); len(problems) != 1 || problems[0].Pointer != tc.pointer {
+				t.Fatalf("invalid object: expected a problem at %s, got %+v", tc.pointer, problems)
+			}
+			if resp := s.validateBody(newReq("POST", "CREATE", tc.apiFamily, tc.resourceName, "", nil, nil), tc.invalid, false); resp != nil {
+				t.Fatalf("invalid object rejected by a subset spec: %d", resp.StatusCode)
+			}
+
+			// Create
+			b, _ := json.Marshal(tc.valid)
+			resp := s.CreateGenericObject(newReq("POST", "CREATE", tc.apiFamily, tc.resourceName, "", b, nil))
+			if resp.StatusCode != http.StatusCreated {
+				t.Fatalf("create: expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+			}
+			id := resp.Body.(map[string]any)["id"].(string)
+
+			// Retrieve
+			resp = s.GetGenericObject(newReq("GET", "READ", tc.apiFamily, tc.resourceName, id, nil, nil))
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("get: expected 200, got %d", resp.StatusCode)
+			}
+
+			// List
+			resp = s.ListGenericObjects(newReq("GET", "LIST", tc.apiFamily, tc.resourceName, "", nil, url.Values{}))
+			if items, ok := resp.Body.([]map[string]any); resp.StatusCode != http.StatusOK || !ok || len(items) != 1 {
+				t.Fatalf("list: expected one object, got %d %v", resp.StatusCode, resp.Body)
+			}
+
+			// Update
+			b, _ = json.Marshal(map[string]any{"@type": tc.valid["@type"], "version": "1.1", "description": "updated"})
+			resp = s.UpdateGenericObject(newReq("PATCH", "UPDATE", tc.apiFamily, tc.resourceName, id, b, nil))
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("update: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+			}
+
+			// Delete
+			resp = s.DeleteGenericObject(newReq("DELETE", "DELETE", tc.apiFamily, tc.resourceName, id, nil, nil))
+			if resp.StatusCode != http.StatusNoContent {
+				t.Fatalf("delete: expected 204, got %d", resp.StatusCode)
+			}
+		})
+	}
+}
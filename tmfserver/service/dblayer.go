@@ -14,6 +14,15 @@ import (
 	"github.com/mattn/go-sqlite3"
 )
 
+// database is the subset of the sqlx API used by the service. It is implemented by *sqlx.DB and by
+// *sqlx.Tx, so the operations can run inside a transaction.
+type database interface {
+	NamedExec(query string, arg any) (sql.Result, error)
+	Exec(query string, args ...any) (sql.Result, error)
+	Get(dest any, query string, args ...any) error
+	Select(dest any, query string, args ...any) error
+}
+
 // createObject creates a new TMF object.
 func (svc *Service) createObject(obj *repo.TMFObject) error {
 	slog.Debug("Service: Creating object", slog.String("id", obj.ID), slog.String("type", obj.Type), slog.String("version", obj.Version))
@@ -126,7 +135,7 @@ func (svc *Service) listObjects(objectType string, queryParams url.Values) ([]re
 	filterClauses := []string{}
 	for key, values := range queryParams {
 		// TMF630 reserved words for query parameters
-		if key == "limit" || key == "offset" || key == "sort" || key == "fields" {
+		if key == "limit" || key == "offset" || key == "sort" || key == "fields" || key == "expand" {
 			continue
 		}
 		// Assuming simple equality filter for now
@@ -0,0 +1,561 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hesusruiz/isbetmf/config"
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	"github.com/hesusruiz/isbetmf/internal/jpath"
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+// EventManagementAPI is the API family of the TMF688 Event Management API.
+// Its own events (creation and deletion of topics) are published with this API family.
+const EventManagementAPI = "eventManagement"
+
+// EventManagementPrefix is the path prefix of the TMF688 Event Management API.
+const EventManagementPrefix = "/tmf-api/eventManagement/v4"
+
+// registerDefaultTopics creates one topic for each API family implemented by the server,
+// so consumers can poll the events of the API family without creating the topic first.
+func (svc *Service) registerDefaultTopics() {
+	for _, family := range defaultTopics() {
+		// Errors are not possible because the registry is fresh and names are unique
+		_, _ = svc.notif.CreateTopic(&notifications.Topic{ID: family, Name: family})
+	}
+}
+
+// defaultTopics returns the identifiers of the topics of the API families implemented by the server
+func defaultTopics() []string {
+	families := []string{EventManagementAPI}
+	for _, management := range config.GeneratedISBEResourceToManagement {
+		if !slices.Contains(families, management) {
+			families = append(families, management)
+		}
+	}
+	return families
+}
+
+// isFamilyTopic returns true if the events of the topic are the ones published by the server for an
+// API family, which must not be forged by the clients
+func (svc *Service) isFamilyTopic(topicID string) bool {
+	return slices.Contains(defaultTopics(), topicID) || svc.knownFamily(topicID)
+}
+
+// eventReadable returns true if the caller can read the event. The events of TMF objects carry the object,
+// so the PDP takes the decision as for a read of the object. The rest of events, like the ones of topics,
+// the delete events which only carry the reference to the object and the events created by the clients,
+// can be read by any authenticated caller.
+func (svc *Service) eventReadable(req *Request, token map[string]any, ev *notifications.Event) bool {
+	payload, ok := ev.Payload.(map[string]any)
+	if !ok || ev.APIFamily == EventManagementAPI {
+		return true
+	}
+	resourceName, _ := payload["resourceName"].(string)
+	if resourceName == "" {
+		return true
+	}
+
+	resource := jpath.GetMap(payload, "event.resource")
+	if isReference(resource) {
+		return true
+	}
+
+	// An event without the object can not be authorized
+	content, err := json.Marshal(resource)
+	if err != nil {
+		return false
+	}
+	resourceID, _ := payload["resourceId"].(string)
+	obj := repo.NewTMFObject(resourceID, resourceName, "", "", content)
+	if err := takeDecision(svc.ruleEngine, readRequest(req, ev.APIFamily, resourceName, resourceID), token, obj); err != nil {
+		slog.Debug("Event not readable by the caller", slog.String("id", ev.ID), slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+// isReference returns true if the object only has the fields of a reference to it
+func isReference(obj map[string]any) bool {
+	if len(obj) == 0 {
+		return false
+	}
+	for key := range obj {
+		switch key {
+		case "id", "href", "@type", "@referredType":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ListTopics returns the TMF688 topics.
+func (svc *Service) ListTopics(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	topics := svc.notif.ListTopics()
+	responseData := make([]map[string]any, 0, len(topics))
+	for _, t := range topics {
+		responseData = append(responseData, topicToTMF(t))
+	}
+
+	headers := map[string]string{"X-Total-Count": strconv.Itoa(len(responseData))}
+	return &Response{StatusCode: http.StatusOK, Headers: headers, Body: responseData}
+}
+
+// GetTopic retrieves a TMF688 topic.
+func (svc *Service) GetTopic(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	t, err := svc.notif.GetTopic(req.ID)
+	if err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.ID, err))
+	}
+
+	return &Response{StatusCode: http.StatusOK, Body: topicToTMF(t)}
+}
+
+// CreateTopic creates a TMF688 topic. The name of the topic is the API family whose
+// events are published on it.
+func (svc *Service) CreateTopic(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		return badRequestResponse(errl.Errorf("failed to bind request body: %w", err))
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		return badRequestResponse(errl.Errorf("name is required"))
+	}
+
+	t := &notifications.Topic{Name: name, Owner: req.AuthUser.OrganizationIdentifier}
+	t.ID, _ = body["id"].(string)
+	t.ContentQuery, _ = body["contentQuery"].(string)
+	t.HeaderQuery, _ = body["headerQuery"].(string)
+
+	if t.ID != "" && svc.isFamilyTopic(t.ID) {
+		err := errl.Errorf("topic %s is reserved for the events of the API family", t.ID)
+		apiErr := NewApiError("409", "Conflict", err.Error(), fmt.Sprintf("%d", http.StatusConflict), "")
+		return &Response{StatusCode: http.StatusConflict, Body: apiErr}
+	}
+
+	if _, err := svc.notif.CreateTopic(t); err != nil {
+		if notifications.IsTopicExists(err) {
+			apiErr := NewApiError("409", "Conflict", err.Error(), fmt.Sprintf("%d", http.StatusConflict), "")
+			return &Response{StatusCode: http.StatusConflict, Body: apiErr}
+		}
+		return internalErrorResponse(errl.Errorf("failed to create topic: %w", err))
+	}
+
+	tmfTopic := topicToTMF(t)
+	svc.publishTopicEvent(req, "TopicCreateEvent", tmfTopic)
+
+	headers := map[string]string{"Location": tmfTopic["href"].(string)}
+	return &Response{StatusCode: http.StatusCreated, Headers: headers, Body: tmfTopic}
+}
+
+// DeleteTopic deletes a TMF688 topic. Only the organization which created a topic can delete it,
+// and the topics of the API families can not be deleted.
+func (svc *Service) DeleteTopic(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	t, err := svc.notif.GetTopic(req.ID)
+	if err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.ID, err))
+	}
+
+	if svc.isFamilyTopic(t.ID) {
+		err = errl.Errorf("topic %s carries the events of the API family and can not be deleted", t.ID)
+	} else if t.Owner == "" || t.Owner != req.AuthUser.OrganizationIdentifier {
+		err = errl.Errorf("topic %s was not created by the caller", t.ID)
+	}
+	if err != nil {
+		apiErr := NewApiError("403", "Forbidden", err.Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		slog.Error("Unauthorized request", slog.Any("error", err))
+		return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	if err := svc.notif.DeleteTopic(req.ID); err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.ID, err))
+	}
+
+	svc.publishTopicEvent(req, "TopicDeleteEvent", topicToTMF(t))
+
+	return &Response{StatusCode: http.StatusNoContent}
+}
+
+// ListEvents returns the recent events, optionally restricted to a topic.
+// It supports the query parameters 'eventType' (comma-separated list), 'eventTime.gt',
+// 'eventTime.gte', 'eventTime.lt', 'eventTime.lte', 'limit' and 'offset'.
+// Events are returned oldest first, so a consumer can poll with 'eventTime.gt' set to the
+// time of the last event received.
+func (svc *Service) ListEvents(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	if req.TopicID != "" {
+		if _, err := svc.notif.GetTopic(req.TopicID); err != nil {
+			return notFoundResponse(errl.Errorf("topic %s: %w", req.TopicID, err))
+		}
+	}
+
+	filter, err := eventFilterFromQuery(req.QueryParams)
+	if err != nil {
+		return badRequestResponse(err)
+	}
+	filter.APIFamily = req.TopicID
+
+	// Only the events the caller can read are returned, and counted
+	var events []*notifications.Event
+	for _, ev := range svc.notif.Events().List(filter) {
+		if svc.eventReadable(req, token, ev) {
+			events = append(events, ev)
+		}
+	}
+	totalCount := len(events)
+
+	// Apply pagination after filtering
+	offset, _ := strconv.Atoi(req.QueryParams.Get("offset"))
+	if offset < 0 || offset > len(events) {
+		offset = len(events)
+	}
+	events = events[offset:]
+	if limit, err := strconv.Atoi(req.QueryParams.Get("limit")); err == nil && limit >= 0 && limit < len(events) {
+		events = events[:limit]
+	}
+
+	responseData := make([]map[string]any, 0, len(events))
+	for _, ev := range events {
+		responseData = append(responseData, EventToTMF(ev))
+	}
+
+	headers := map[string]string{"X-Total-Count": strconv.Itoa(totalCount)}
+	return &Response{StatusCode: http.StatusOK, Headers: headers, Body: responseData}
+}
+
+// GetEvent retrieves a recent event by its identifier, optionally checking it belongs to a topic.
+func (svc *Service) GetEvent(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	ev := svc.notif.Events().Get(req.ID)
+	if ev == nil || (req.TopicID != "" && ev.APIFamily != req.TopicID) {
+		return notFoundResponse(errl.Errorf("event %s not found", req.ID))
+	}
+	if !svc.eventReadable(req, token, ev) {
+		apiErr := NewApiError("403", "Forbidden", errl.Errorf("PDP: request rejected due to policy").Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	return &Response{StatusCode: http.StatusOK, Body: EventToTMF(ev)}
+}
+
+// CreateEvent publishes an event on a topic, delivering it to the subscribers of the topic.
+// Only the topics created by the clients accept events: the topics of the API families carry the events
+// of the objects, which are published by the server.
+func (svc *Service) CreateEvent(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	if _, err := svc.notif.GetTopic(req.TopicID); err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.TopicID, err))
+	}
+	if svc.isFamilyTopic(req.TopicID) {
+		apiErr := NewApiError("403", "Forbidden", errl.Errorf("events can not be published on topic %s of an API family", req.TopicID).Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		return badRequestResponse(errl.Errorf("failed to bind request body: %w", err))
+	}
+
+	eventType, _ := body["eventType"].(string)
+	if eventType == "" {
+		return badRequestResponse(errl.Errorf("eventType is required"))
+	}
+
+	// The server is the authority for identifiers and time of events
+	body["eventId"] = uuid.NewString()
+	body["eventTime"] = time.Now().Format(time.RFC3339Nano)
+	if _, ok := body["@type"]; !ok {
+		body["@type"] = "Event"
+	}
+
+	svc.notif.PublishEvent(req.TopicID, eventType, body)
+
+	ev := svc.notif.Events().Get(body["eventId"].(string))
+	if ev == nil {
+		return internalErrorResponse(errl.Errorf("event was not recorded"))
+	}
+	tmfEvent := EventToTMF(ev)
+
+	headers := map[string]string{"Location": tmfEvent["href"].(string)}
+	return &Response{StatusCode: http.StatusCreated, Headers: headers, Body: tmfEvent}
+}
+
+// ListTopicHubs returns the subscriptions to a topic created by the caller.
+func (svc *Service) ListTopicHubs(req *Request) *Response {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	if _, err := svc.notif.GetTopic(req.TopicID); err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.TopicID, err))
+	}
+
+	subs, err := svc.notif.ListSubscriptions(req.TopicID)
+	if err != nil {
+		return internalErrorResponse(errl.Errorf("failed to list subscriptions: %w", err))
+	}
+	slices.SortFunc(subs, func(a, b *notifications.Subscription) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	responseData := make([]map[string]any, 0, len(subs))
+	for _, sub := range subs {
+		if sub.Owner != "" && sub.Owner == req.AuthUser.OrganizationIdentifier {
+			responseData = append(responseData, topicHubToTMF(req.TopicID, sub))
+		}
+	}
+
+	headers := map[string]string{"X-Total-Count": strconv.Itoa(len(responseData))}
+	return &Response{StatusCode: http.StatusOK, Headers: headers, Body: responseData}
+}
+
+// GetTopicHub retrieves a subscription to a topic, if the caller created it.
+func (svc *Service) GetTopicHub(req *Request) *Response {
+	hubReq := *req
+	hubReq.APIfamily = req.TopicID
+	sub, resp := svc.ownedHubSubscription(&hubReq)
+	if resp != nil {
+		return resp
+	}
+
+	hub := topicHubToTMF(req.TopicID, sub)
+	if stats, err := svc.notif.SubscriptionStats(req.TopicID, req.ID); err == nil {
+		hub["status"] = stats.Status
+		hub["deliveryStats"] = deliveryStatsToTMF(stats)
+	}
+	return &Response{StatusCode: http.StatusOK, Body: hub}
+}
+
+// CreateTopicHub creates a subscription to a topic. It is equivalent to creating a hub
+// subscription on the API family of the topic.
+func (svc *Service) CreateTopicHub(req *Request) *Response {
+	if _, err := svc.notif.GetTopic(req.TopicID); err != nil {
+		return notFoundResponse(errl.Errorf("topic %s: %w", req.TopicID, err))
+	}
+
+	hubReq := *req
+	hubReq.APIfamily = req.TopicID
+	resp := svc.CreateHubSubscription(&hubReq)
+	if resp.StatusCode != http.StatusCreated {
+		return resp
+	}
+
+	// Adjust the href to the TMF688 resource
+	if body, ok := resp.Body.(map[string]any); ok {
+		body["href"] = fmt.Sprintf("%s/topic/%s/hub/%s", EventManagementPrefix, req.TopicID, body["id"])
+	}
+	return resp
+}
+
+// DeleteTopicHub deletes a subscription to a topic.
+func (svc *Service) DeleteTopicHub(req *Request) *Response {
+	hubReq := *req
+	hubReq.APIfamily = req.TopicID
+	return svc.DeleteHubSubscription(&hubReq)
+}
+
+// publishTopicEvent publishes the events of the Event Management API itself
+func (svc *Service) publishTopicEvent(req *Request, eventType string, topic map[string]any) {
+	eventReq := *req
+	eventReq.APIfamily = EventManagementAPI
+	eventReq.ResourceName = "topic"
+	eventReq.ID, _ = topic["id"].(string)
+	svc.notif.PublishEvent(EventManagementAPI, eventType, buildEventPayload(&eventReq, eventType, topic))
+}
+
+// eventFilterFromQuery builds an event filter from the query parameters of the request
+func eventFilterFromQuery(qp map[string][]string) (notifications.EventFilter, error) {
+	var f notifications.EventFilter
+
+	get := func(key string) string {
+		if v := qp[key]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	if et := get("eventType"); et != "" {
+		for _, t := range strings.Split(et, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				f.EventTypes = append(f.EventTypes, t)
+			}
+		}
+	}
+
+	parse := func(key string) (time.Time, bool, error) {
+		v := get(key)
+		if v == "" {
+			return time.Time{}, false, nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false, errl.Errorf("invalid %s: %w", key, err)
+		}
+		return t, true, nil
+	}
+
+	// The filter uses an inclusive lower bound and an exclusive upper bound
+	if t, ok, err := parse("eventTime.gte"); err != nil {
+		return f, err
+	} else if ok {
+		f.From = t
+	}
+	if t, ok, err := parse("eventTime.gt"); err != nil {
+		return f, err
+	} else if ok {
+		f.From = t.Add(time.Nanosecond)
+	}
+	if t, ok, err := parse("eventTime.lt"); err != nil {
+		return f, err
+	} else if ok {
+		f.To = t
+	}
+	if t, ok, err := parse("eventTime.lte"); err != nil {
+		return f, err
+	} else if ok {
+		f.To = t.Add(time.Nanosecond)
+	}
+
+	return f, nil
+}
+
+// EventToTMF converts an event retained by the notifications manager to a TMF688 Event.
+func EventToTMF(ev *notifications.Event) map[string]any {
+	m := map[string]any{
+		"id":        ev.ID,
+		"href":      fmt.Sprintf("%s/topic/%s/event/%s", EventManagementPrefix, ev.APIFamily, ev.ID),
+		"eventId":   ev.ID,
+		"eventTime": ev.Time.Format(time.RFC3339Nano),
+		"eventType": ev.EventType,
+		"domain":    ev.APIFamily,
+		"@type":     "Event",
+	}
+	if pm, ok := ev.Payload.(map[string]any); ok {
+		for _, key := range []string{"event", "title", "description", "priority", "correlationId", "source", "reportingSystem", "relatedParty", "analyticCharacteristic", "timeOcurred"} {
+			if v, ok := pm[key]; ok {
+				m[key] = v
+			}
+		}
+	} else if ev.Payload != nil {
+		m["event"] = ev.Payload
+	}
+	return m
+}
+
+// topicToTMF converts a topic to its TMF688 representation
+func topicToTMF(t *notifications.Topic) map[string]any {
+	m := map[string]any{
+		"id":    t.ID,
+		"href":  fmt.Sprintf("%s/topic/%s", EventManagementPrefix, t.ID),
+		"name":  t.Name,
+		"@type": "Topic",
+	}
+	if t.ContentQuery != "" {
+		m["contentQuery"] = t.ContentQuery
+	}
+	if t.HeaderQuery != "" {
+		m["headerQuery"] = t.HeaderQuery
+	}
+	return m
+}
+
+// topicHubToTMF converts a subscription to its TMF688 Hub representation
+func topicHubToTMF(topicID string, sub *notifications.Subscription) map[string]any {
+	return map[string]any{
+		"id":       sub.ID,
+		"href":     fmt.Sprintf("%s/topic/%s/hub/%s", EventManagementPrefix, topicID, sub.ID),
+		"callback": sub.Callback,
+		"query":    sub.Query,
+		"@type":    "Hub",
+	}
+}
+
+func unauthorizedResponse(err error) *Response {
+	err = errl.Errorf("invalid access token: %w", err)
+	apiErr := NewApiError("401", "Unauthorized", err.Error(), fmt.Sprintf("%d", http.StatusUnauthorized), "")
+	slog.Error("Unauthorized request", slog.Any("error", err))
+	return &Response{StatusCode: http.StatusUnauthorized, Body: apiErr}
+}
+
+func badRequestResponse(err error) *Response {
+	apiErr := NewApiError("400", "Bad Request", err.Error(), fmt.Sprintf("%d", http.StatusBadRequest), "")
+	return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+}
+
+func notFoundResponse(err error) *Response {
+	apiErr := NewApiError("404", "Not Found", err.Error(), fmt.Sprintf("%d", http.StatusNotFound), "")
+	return &Response{StatusCode: http.StatusNotFound, Body: apiErr}
+}
+
+func internalErrorResponse(err error) *Response {
+	apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
+	slog.Error("Internal error", slog.Any("error", err))
+	return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
+}
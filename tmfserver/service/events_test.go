@@ -0,0 +1,262 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/isbetmf/pdp"
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+)
+
+// newEventReq creates a fresh Request for the Event Management API on a topic
+func newEventReq(method, action, topicID, id string, body []byte, qp url.Values) *Request {
+	req := newReq(method, action, EventManagementAPI, "", id, body, qp)
+	req.TopicID = topicID
+	return req
+}
+
+func TestListEventsByTypeAndTime(t *testing.T) {
+	s := newTestService(t)
+	s.registerDefaultTopics()
+
+	before := time.Now()
+
+	resourceName := "productOffering"
+	b, _ := json.Marshal(map[string]any{"@type": resourceName})
+	cResp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil))
+	if cResp.StatusCode != http.StatusCreated {
+		t.Fatalf("create expected 201, got %d", cResp.StatusCode)
+	}
+	id := cResp.Body.(map[string]any)["id"].(string)
+
+	dResp := s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", resourceName, id, nil, nil))
+	if dResp.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete expected 204, got %d", dResp.StatusCode)
+	}
+
+	// All events of the topic
+	topic := "productCatalogManagement"
+	resp := s.ListEvents(newEventReq("GET", "LIST", topic, "", nil, url.Values{}))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("list events expected 200, got %d", resp.StatusCode)
+	}
+	if events := resp.Body.([]map[string]any); len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	// Filter by type
+	resp = s.ListEvents(newEventReq("GET", "LIST", topic, "", nil, url.Values{"eventType": []string{"ProductOfferingDeleteEvent"}}))
+	events := resp.Body.([]map[string]any)
+	if len(events) != 1 || events[0]["eventType"] != "ProductOfferingDeleteEvent" {
+		t.Fatalf("expected only the delete event, got %v", events)
+	}
+
+	// Get the event by id
+	if gResp := s.GetEvent(newEventReq("GET", "READ", topic, events[0]["id"].(string), nil, nil)); gResp.StatusCode != http.StatusOK {
+		t.Fatalf("get event expected 200, got %d", gResp.StatusCode)
+	}
+
+	// The events of objects the caller can not read, like an object without owner, are not returned
+	hiddenReq := newReq("POST", "CREATE", topic, resourceName, "urn:ngsi-ld:product-offering:hidden", nil, nil)
+	s.notif.PublishEvent(topic, "ProductOfferingCreateEvent", buildEventPayload(hiddenReq, "ProductOfferingCreateEvent",
+		map[string]any{"id": "urn:ngsi-ld:product-offering:hidden", "name": "Private"}))
+	resp = s.ListEvents(newEventReq("GET", "LIST", topic, "", nil, url.Values{}))
+	if events := resp.Body.([]map[string]any); len(events) != 2 || resp.Headers["X-Total-Count"] != "2" {
+		t.Fatalf("expected the 2 readable events, got %d", len(events))
+	}
+	hidden := s.notif.Events().List(notifications.EventFilter{APIFamily: topic})[2]
+	if gResp := s.GetEvent(newEventReq("GET", "READ", topic, hidden.ID, nil, nil)); gResp.StatusCode != http.StatusForbidden {
+		t.Fatalf("get event of unreadable object expected 403, got %d", gResp.StatusCode)
+	}
+
+	// Filter by time
+	resp = s.ListEvents(newEventReq("GET", "LIST", topic, "", nil, url.Values{"eventTime.lt": []string{before.Format(time.RFC3339Nano)}}))
+	if events := resp.Body.([]map[string]any); len(events) != 0 {
+		t.Fatalf("expected no events before the test started, got %d", len(events))
+	}
+
+	if resp = s.ListEvents(newEventReq("GET", "LIST", topic, "", nil, url.Values{"eventTime.gt": []string{"not a time"}})); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid time, got %d", resp.StatusCode)
+	}
+
+	// Unknown topic
+	if resp = s.ListEvents(newEventReq("GET", "LIST", "unknownManagement", "", nil, url.Values{})); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown topic, got %d", resp.StatusCode)
+	}
+}
+
+func TestCreateTopicAndEvent(t *testing.T) {
+	s := newTestService(t)
+
+	b, _ := json.Marshal(map[string]any{"name": "alarms"})
+	resp := s.CreateTopic(newReq("POST", "CREATE", EventManagementAPI, "", "", b, nil))
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create topic expected 201, got %d", resp.StatusCode)
+	}
+
+	// Creating it again is a conflict
+	resp = s.CreateTopic(newReq("POST", "CREATE", EventManagementAPI, "", "", b, nil))
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("duplicate topic expected 409, got %d", resp.StatusCode)
+	}
+
+	eb, _ := json.Marshal(map[string]any{"eventType": "AlarmCreateEvent", "title": "disk full"})
+	resp = s.CreateEvent(newEventReq("POST", "CREATE", "alarms", "", eb, nil))
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create event expected 201, got %d", resp.StatusCode)
+	}
+	ev := resp.Body.(map[string]any)
+	if ev["title"] != "disk full" || ev["eventId"] == "" {
+		t.Fatalf("unexpected event: %v", ev)
+	}
+
+	if events := s.ListEvents(newEventReq("GET", "LIST", "alarms", "", nil, url.Values{})).Body.([]map[string]any); len(events) != 1 {
+		t.Fatalf("expected 1 event in topic, got %d", len(events))
+	}
+
+	// The events of the API families are only published by the server
+	s.registerDefaultTopics()
+	eb, _ = json.Marshal(map[string]any{"eventType": "ProductOfferingCreateEvent"})
+	if resp = s.CreateEvent(newEventReq("POST", "CREATE", "productCatalogManagement", "", eb, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("create event on API family topic expected 403, got %d", resp.StatusCode)
+	}
+}
+
+func TestDeleteTopicChecksOwner(t *testing.T) {
+	s := newTestService(t)
+	s.registerDefaultTopics()
+
+	// The topics of the API families can not be created or deleted by the clients
+	b, _ := json.Marshal(map[string]any{"id": "productCatalogManagement", "name": "catalog"})
+	if resp := s.CreateTopic(newReq("POST", "CREATE", EventManagementAPI, "", "", b, nil)); resp.StatusCode != http.StatusConflict {
+		t.Fatalf("create API family topic expected 409, got %d", resp.StatusCode)
+	}
+	if resp := s.DeleteTopic(newReq("DELETE", "DELETE", EventManagementAPI, "", "productCatalogManagement", nil, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("delete API family topic expected 403, got %d", resp.StatusCode)
+	}
+
+	b, _ = json.Marshal(map[string]any{"id": "alarms", "name": "alarms"})
+	if resp := s.CreateTopic(newReq("POST", "CREATE", EventManagementAPI, "", "", b, nil)); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create topic expected 201, got %d", resp.StatusCode)
+	}
+
+	// Only the organization which created the topic can delete it
+	topic, _ := s.notif.GetTopic("alarms")
+	owner := topic.Owner
+	topic.Owner = "VATES-11111111K"
+	if resp := s.DeleteTopic(newReq("DELETE", "DELETE", EventManagementAPI, "", "alarms", nil, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("delete topic of other organization expected 403, got %d", resp.StatusCode)
+	}
+	topic.Owner = owner
+	if resp := s.DeleteTopic(newReq("DELETE", "DELETE", EventManagementAPI, "", "alarms", nil, nil)); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete topic expected 204, got %d", resp.StatusCode)
+	}
+}
+
+func TestOpenEventStreamResumesFromLastEventID(t *testing.T) {
+	s := newTestService(t)
+
+	resourceName := "productOffering"
+	for i := 0; i < 3; i++ {
+		b, _ := json.Marshal(map[string]any{"@type": resourceName})
+		if resp := s.CreateGenericObject(newReq("POST", "CREATE", "TMF620", resourceName, "", b, nil)); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create expected 201, got %d", resp.StatusCode)
+		}
+	}
+
+	stream, errResp := s.OpenEventStream(newReq("GET", "LIST", "TMF620", "", "", nil, url.Values{"lastEventId": []string{"1"}}))
+	if errResp != nil {
+		t.Fatalf("open stream failed with %d", errResp.StatusCode)
+	}
+	defer stream.Close()
+
+	if len(stream.Replay) != 2 {
+		t.Fatalf("expected 2 replayed events, got %d", len(stream.Replay))
+	}
+	for _, ev := range stream.Replay {
+		if !stream.Accept(ev) {
+			t.Fatalf("replayed event %d should be accepted", ev.Seq)
+		}
+	}
+	if stream.Accept(stream.Replay[0]) {
+		t.Fatalf("duplicate event should not be accepted")
+	}
+
+	msg, err := FormatSSE(stream.Replay[0])
+	if err != nil {
+		t.Fatalf("format: %v", err)
+	}
+	if !strings.HasPrefix(string(msg), "id: 2\nevent: ProductOfferingCreateEvent\ndata: {") {
+		t.Fatalf("unexpected SSE message: %q", msg)
+	}
+
+	// New events are received live
+	b, _ := json.Marshal(map[string]any{"@type": resourceName})
+	s.CreateGenericObject(newReq("POST", "CREATE", "TMF620", resourceName, "", b, nil))
+	select {
+	case ev := <-stream.Live:
+		if ev.Seq != 4 {
+			t.Fatalf("expected live event 4, got %d", ev.Seq)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for live event")
+	}
+
+	if _, errResp := s.OpenEventStream(newReq("GET", "LIST", "TMF620", "", "", nil, url.Values{"lastEventId": []string{"abc"}})); errResp == nil || errResp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid Last-Event-ID")
+	}
+}
+
+func TestEventStreamOnlyAcceptsReadableEvents(t *testing.T) {
+	s := newTestService(t)
+
+	resourceName := "productOffering"
+	create := func() {
+		b, _ := json.Marshal(map[string]any{"@type": resourceName})
+		if resp := s.CreateGenericObject(newReq("POST", "CREATE", "TMF620", resourceName, "", b, nil)); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create expected 201, got %d", resp.StatusCode)
+		}
+	}
+	create()
+	create()
+
+	// The caller can create the objects, but not read them
+	policyFile := filepath.Join(t.TempDir(), "policies.star")
+	os.WriteFile(policyFile, []byte("def authorize():\n    return input.request.action != \"READ\"\n"), 0o644)
+	ruleEngine, err := pdp.NewPDP(&pdp.Config{PolicyFileName: policyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ruleEngine = ruleEngine
+
+	stream, errResp := s.OpenEventStream(newReq("GET", "LIST", "TMF620", "", "", nil, url.Values{"lastEventId": []string{"1"}}))
+	if errResp != nil {
+		t.Fatalf("open stream failed with %d", errResp.StatusCode)
+	}
+	defer stream.Close()
+
+	if len(stream.Replay) != 1 {
+		t.Fatalf("expected 1 replayed event, got %d", len(stream.Replay))
+	}
+	for _, ev := range stream.Replay {
+		if stream.Accept(ev) {
+			t.Fatalf("replayed event %d should not be readable", ev.Seq)
+		}
+	}
+
+	create()
+	select {
+	case ev := <-stream.Live:
+		if stream.Accept(ev) {
+			t.Fatalf("live event %d should not be readable", ev.Seq)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for live event")
+	}
+}
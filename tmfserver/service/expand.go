@@ -0,0 +1,153 @@
+package service
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+)
+
+// maxExpandDepth limits the levels of the paths in the expand query parameter, like
+// "productSpecification.resourceSpecification"
+const maxExpandDepth = 3
+
+// maxExpandedObjects limits the number of objects embedded in a response
+const maxExpandedObjects = 1000
+
+// parseExpand parses the expand query parameter, a comma-separated list of paths of properties separated
+// by dots. It returns nil if there is nothing to expand.
+func parseExpand(queryParams url.Values) ([][]string, error) {
+	var paths [][]string
+	for _, entry := range strings.Split(queryParams.Get("expand"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		path := strings.Split(entry, ".")
+		if len(path) > maxExpandDepth {
+			return nil, errl.Errorf("expand path %s is too deep, the maximum is %d levels", entry, maxExpandDepth)
+		}
+		for _, p := range path {
+			if p == "" {
+				return nil, errl.Errorf("invalid expand path %s", entry)
+			}
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// expander replaces the references (*Ref objects) in the objects of a response with the objects referenced,
+// retrieved from the local store. The caller must be authorized by the PDP to read each embedded object,
+// and the references which can not be resolved are left as they are.
+type expander struct {
+	svc   *Service
+	req   *Request
+	token map[string]any
+	count int
+
+	// Content of the objects already retrieved, or nil if they do not exist or can not be read
+	cache map[string][]byte
+}
+
+// expandObjects expands the paths in the expand query parameter of the request in each object.
+// It returns a response if the parameter is invalid, or nil otherwise.
+func (svc *Service) expandObjects(req *Request, token map[string]any, objs ...map[string]any) *Response {
+	paths, err := parseExpand(req.QueryParams)
+	if err != nil {
+		return badRequestResponse(err)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	e := &expander{svc: svc, req: req, token: token, cache: map[string][]byte{}}
+	for _, obj := range objs {
+		for _, path := range paths {
+			e.expand(obj, path)
+		}
+	}
+	slog.Debug("Expanded references", slog.String("resourceName", req.ResourceName), slog.Int("count", e.count))
+	return nil
+}
+
+// expand replaces the references in the property of obj at the start of path, which can be a single
+// reference or an array of them, and continues with the rest of the path in the objects embedded
+func (e *expander) expand(obj map[string]any, path []string) {
+	switch v := obj[path[0]].(type) {
+	case map[string]any:
+		obj[path[0]] = e.expandValue(v, path[1:])
+	case []any:
+		for i, item := range v {
+			if m, ok := item.(map[string]any); ok {
+				v[i] = e.expandValue(m, path[1:])
+			}
+		}
+	}
+}
+
+// expandValue returns the object referenced by value, if it is a reference which can be resolved, or
+// value itself otherwise. Values which are not references can contain them, like the items of a bundle.
+func (e *expander) expandValue(value map[string]any, rest []string) map[string]any {
+	result := value
+	if ref, ok := asReference(value); ok {
+		if target := e.resolve(ref); target != nil {
+			result = target
+		}
+	}
+	if len(rest) > 0 {
+		e.expand(result, rest)
+	}
+	return result
+}
+
+// resolve returns a new copy of the object referenced, or nil if it does not exist or the caller can not read it
+func (e *expander) resolve(ref reference) map[string]any {
+	key := ref.resourceName + "/" + ref.id
+	content, cached := e.cache[key]
+	if !cached {
+		if e.count >= maxExpandedObjects {
+			return nil
+		}
+		content = e.retrieve(ref)
+		e.cache[key] = content
+		if content != nil {
+			e.count++
+		}
+	}
+	if content == nil {
+		return nil
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(content, &obj); err != nil {
+		return nil
+	}
+	return obj
+}
+
+// retrieve returns the content of the object referenced, if the PDP allows the caller to read it
+func (e *expander) retrieve(ref reference) []byte {
+	apiFamily, ok := e.svc.resourceFamily(ref.resourceName)
+	if !ok {
+		return nil
+	}
+
+	obj, err := e.svc.getObject(ref.id, ref.resourceName)
+	if err != nil {
+		slog.Error("Failed to retrieve referenced object", slog.String("id", ref.id), slog.String("resourceName", ref.resourceName), slog.Any("error", err))
+		return nil
+	}
+	if obj == nil {
+		return nil
+	}
+
+	// The decision is taken for a read of the referenced object by the caller
+	if err := takeDecision(e.svc.ruleEngine, readRequest(e.req, apiFamily, ref.resourceName, ref.id), e.token, obj); err != nil {
+		slog.Info("Referenced object not expanded", slog.String("id", ref.id), slog.String("resourceName", ref.resourceName), slog.Any("error", err))
+		return nil
+	}
+	return obj.Content
+}
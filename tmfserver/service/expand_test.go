@@ -0,0 +1,84 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+func TestExpand(t *testing.T) {
+	s := newTestService(t)
+
+	create := func(resourceName string, obj map[string]any) {
+		t.Helper()
+		b, _ := json.Marshal(obj)
+		if resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil)); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create %s: expected 201, got %d", resourceName, resp.StatusCode)
+		}
+	}
+
+	create("category", map[string]any{"id": "urn:ngsi-ld:category:1", "name": "Storage"})
+	create("productSpecification", map[string]any{
+		"id":       "urn:ngsi-ld:product-specification:1",
+		"name":     "Storage spec",
+		"category": []any{map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1"}},
+	})
+
+	// An object without owner, which the PDP does not allow to read
+	s.createObject(repo.NewTMFObject("urn:ngsi-ld:category:2", "category", "1.0", "", []byte(`{"id":"urn:ngsi-ld:category:2","name":"Hidden"}`)))
+
+	create("productOffering", map[string]any{
+		"id":                   "urn:ngsi-ld:product-offering:1",
+		"name":                 "Cloud storage",
+		"productSpecification": map[string]any{"@type": "ProductSpecificationRef", "id": "urn:ngsi-ld:product-specification:1"},
+		"category": []any{
+			map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1"},
+			map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:2"},
+			map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:3"},
+		},
+	})
+
+	qp := url.Values{"expand": {"productSpecification.category,category"}}
+	resp := s.GetGenericObject(newReq("GET", "READ", "productCatalogManagement", "productOffering", "urn:ngsi-ld:product-offering:1", nil, qp))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("get: expected 200, got %d", resp.StatusCode)
+	}
+	offering := resp.Body.(map[string]any)
+
+	spec := offering["productSpecification"].(map[string]any)
+	if spec["name"] != "Storage spec" {
+		t.Fatalf("specification not expanded: %v", spec)
+	}
+	if category := spec["category"].([]any)[0].(map[string]any); category["name"] != "Storage" {
+		t.Fatalf("category of specification not expanded: %v", category)
+	}
+
+	// Only the categories which exist and can be read are expanded
+	categories := offering["category"].([]any)
+	if categories[0].(map[string]any)["name"] != "Storage" {
+		t.Fatalf("category not expanded: %v", categories[0])
+	}
+	if categories[1].(map[string]any)["@type"] != "CategoryRef" || categories[2].(map[string]any)["@type"] != "CategoryRef" {
+		t.Fatalf("unexpected expansion: %v", categories)
+	}
+
+	// List
+	resp = s.ListGenericObjects(newReq("GET", "LIST", "productCatalogManagement", "productOffering", "", nil, url.Values{"expand": {"productSpecification"}}))
+	items, _ := resp.Body.([]map[string]any)
+	if resp.StatusCode != http.StatusOK || len(items) != 1 {
+		t.Fatalf("list: expected one object, got %d %v", resp.StatusCode, resp.Body)
+	}
+	if spec := items[0]["productSpecification"].(map[string]any); spec["name"] != "Storage spec" {
+		t.Fatalf("list: specification not expanded: %v", spec)
+	}
+
+	// Depth is limited
+	qp = url.Values{"expand": {"productSpecification.category.parent.parent"}}
+	resp = s.GetGenericObject(newReq("GET", "READ", "productCatalogManagement", "productOffering", "urn:ngsi-ld:product-offering:1", nil, qp))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("get: expected 400 for deep expansion, got %d", resp.StatusCode)
+	}
+}
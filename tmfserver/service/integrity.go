@@ -0,0 +1,308 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+// IntegrityMode selects what happens when a reference to another object is broken
+type IntegrityMode string
+
+const (
+	// IntegrityOff disables the checks
+	IntegrityOff IntegrityMode = "off"
+	// IntegrityWarn logs the broken references but accepts the request
+	IntegrityWarn IntegrityMode = "warn"
+	// IntegrityStrict rejects the request
+	IntegrityStrict IntegrityMode = "strict"
+)
+
+// maxReferencesReported limits the number of referencing objects reported when a delete is rejected
+const maxReferencesReported = 10
+
+// IntegrityPolicy configures the checks of the references (the *Ref objects) between TMF objects.
+// The mode of a resource applies to the references to its objects: they must exist when an object
+// referencing them is created or updated, and they can not be deleted while they are referenced.
+type IntegrityPolicy struct {
+	Default   IntegrityMode
+	Resources map[string]IntegrityMode
+}
+
+// ParseIntegrityPolicy parses a comma-separated list of modes, like "warn,category=strict,organization=off".
+// An entry without a resource name sets the default mode, which is off if not specified.
+func ParseIntegrityPolicy(s string) (*IntegrityPolicy, error) {
+	policy := &IntegrityPolicy{Default: IntegrityOff, Resources: map[string]IntegrityMode{}}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		resourceName, modeName, found := strings.Cut(entry, "=")
+		if !found {
+			resourceName, modeName = "", entry
+		}
+
+		mode := IntegrityMode(strings.ToLower(strings.TrimSpace(modeName)))
+		if mode != IntegrityOff && mode != IntegrityWarn && mode != IntegrityStrict {
+			return nil, errl.Errorf("invalid integrity mode %q, expected off, warn or strict", modeName)
+		}
+
+		if resourceName = strings.TrimSpace(resourceName); resourceName == "" {
+			policy.Default = mode
+		} else {
+			policy.Resources[resourceName] = mode
+		}
+	}
+	return policy, nil
+}
+
+// Mode returns the mode applied to the references to the objects of a resource
+func (p *IntegrityPolicy) Mode(resourceName string) IntegrityMode {
+	if p == nil {
+		return IntegrityOff
+	}
+	if mode, ok := p.Resources[resourceName]; ok {
+		return mode
+	}
+	return p.Default
+}
+
+// SetIntegrity sets the policy for the checks of the references between objects. A nil policy disables them.
+func (svc *Service) SetIntegrity(policy *IntegrityPolicy) {
+	svc.integrity = policy
+}
+
+// reference is a *Ref object found inside a TMF object
+type reference struct {
+	pointer      string
+	resourceName string
+	id           string
+	href         string
+}
+
+// findReferences returns the references in an object, with the JSON pointer (RFC 6901) to each one.
+// References are the objects with an id and either a @referredType or a @type ending in "Ref", and the
+// resource of the referenced object is taken from the @referredType or from the @type without "Ref".
+// The Seller and SellerOperator related parties are set by the server, so they are not included.
+func findReferences(obj map[string]any) []reference {
+	var refs []reference
+	var walk func(pointer string, value any)
+	walk = func(pointer string, value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			if role, _ := v["role"].(string); strings.EqualFold(role, "seller") || strings.EqualFold(role, "sellerOperator") {
+				return
+			}
+			if pointer != "" {
+				if ref, ok := asReference(v); ok {
+					ref.pointer = pointer
+					refs = append(refs, ref)
+				}
+			}
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				walk(pointer+"/"+escapePointer(key), v[key])
+			}
+		case []any:
+			for i, item := range v {
+				walk(pointer+"/"+strconv.Itoa(i), item)
+			}
+		}
+	}
+	walk("", obj)
+	return refs
+}
+
+// asReference returns the reference represented by an object, if it is one
+func asReference(obj map[string]any) (reference, bool) {
+	id, _ := obj["id"].(string)
+	if id == "" {
+		return reference{}, false
+	}
+
+	referredType, _ := obj["@referredType"].(string)
+	if referredType == "" {
+		typeVal, _ := obj["@type"].(string)
+		if !strings.HasSuffix(typeVal, "Ref") {
+			return reference{}, false
+		}
+		referredType = strings.TrimSuffix(typeVal, "Ref")
+	}
+	if referredType == "" {
+		return reference{}, false
+	}
+
+	href, _ := obj["href"].(string)
+	return reference{
+		resourceName: strings.ToLower(referredType[:1]) + referredType[1:],
+		id:           id,
+		href:         href,
+	}, true
+}
+
+// escapePointer escapes a key to be used in a JSON pointer
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// checkReferences verifies that the objects referenced by the object in the body of a create or update
+// request exist, together with the extra references given, like the ones required by a new lifecycleStatus.
+// It returns a response with the broken references if the request must be rejected, or nil.
+// References to resources not implemented by the server can not be checked, and are ignored.
+func (svc *Service) checkReferences(req *Request, obj map[string]any, extra ...reference) *Response {
+	refs := findReferences(obj)
+	for _, ref := range extra {
+		if !slices.ContainsFunc(refs, func(r reference) bool { return r.pointer == ref.pointer }) {
+			refs = append(refs, ref)
+		}
+	}
+
+	details, err := svc.brokenReferences(req, refs)
+	if err != nil {
+		return internalErrorResponse(err)
+	}
+	if len(details) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(details))
+	for i, d := range details {
+		messages[i] = d.Pointer + ": " + d.Message
+	}
+	apiErr := NewApiError("400", "Bad Request", "broken references: "+strings.Join(messages, "; "), fmt.Sprintf("%d", http.StatusBadRequest), "")
+	apiErr.Details = details
+	slog.Error("Object rejected by integrity checks", slog.String("resourceName", req.ResourceName), slog.Int("problems", len(details)))
+	return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+}
+
+// brokenReferences checks the references with the mode of the policy for the resources referenced.
+// It returns the problems of the references which must be rejected, and logs the ones which are only warned.
+func (svc *Service) brokenReferences(req *Request, refs []reference) ([]ErrorDetail, error) {
+	if svc.integrity == nil {
+		return nil, nil
+	}
+
+	var details []ErrorDetail
+	for _, ref := range refs {
+		mode := svc.integrity.Mode(ref.resourceName)
+		if mode == IntegrityOff {
+			continue
+		}
+
+		problem, err := svc.brokenReference(ref)
+		if err != nil {
+			return nil, err
+		}
+		if problem == "" {
+			continue
+		}
+
+		if mode == IntegrityWarn {
+			slog.Warn("Broken reference", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID),
+				slog.String("pointer", ref.pointer), slog.String("problem", problem))
+			continue
+		}
+		details = append(details, ErrorDetail{Pointer: ref.pointer, Message: problem})
+	}
+	return details, nil
+}
+
+// brokenReference returns the problem with a reference, or an empty string if the object referenced exists.
+// References to resources not implemented by the server can not be checked, and are not reported.
+func (svc *Service) brokenReference(ref reference) (string, error) {
+	if _, ok := svc.resourceFamily(ref.resourceName); !ok {
+		return "", nil
+	}
+	if ref.href != "" && !strings.HasSuffix(ref.href, "/"+ref.id) && ref.href != ref.id {
+		return fmt.Sprintf("href %s does not correspond to id %s", ref.href, ref.id), nil
+	}
+	existing, err := svc.getObject(ref.id, ref.resourceName)
+	if err != nil {
+		return "", errl.Errorf("failed to check reference: %w", err)
+	}
+	if existing == nil {
+		return fmt.Sprintf("%s %s does not exist", ref.resourceName, ref.id), nil
+	}
+	return "", nil
+}
+
+// checkReferencedBy verifies that the object being deleted is not referenced by other objects.
+// It returns a 409 response if the delete must be rejected, or nil. The response lists the referencing
+// objects which the caller can read, and only counts the others, so it does not disclose private objects.
+// Only the built-in SQLite storage can be searched for references.
+func (svc *Service) checkReferencedBy(req *Request, token map[string]any) *Response {
+	mode := svc.integrity.Mode(req.ResourceName)
+	if mode == IntegrityOff || svc.storage != nil {
+		return nil
+	}
+
+	// Candidates are found by searching the id in the content of the latest version of the objects,
+	// and confirmed looking at their references. The latest version is found with the primary key.
+	var candidates []struct {
+		ID      string `db:"id"`
+		Type    string `db:"type"`
+		Content []byte `db:"content"`
+	}
+	needle, _ := json.Marshal(req.ID)
+	err := svc.db.Select(&candidates, `
+		SELECT t1.id, t1.type, t1.content
+		FROM tmf_object t1
+		WHERE instr(t1.content, ?) > 0 AND NOT (t1.id = ? AND t1.type = ?)
+		AND t1.version = (SELECT MAX(t2.version) FROM tmf_object t2 WHERE t2.id = t1.id AND t2.type = t1.type)`,
+		string(needle), req.ID, req.ResourceName)
+	if err != nil {
+		return internalErrorResponse(errl.Errorf("failed to search references: %w", err))
+	}
+
+	var referencedBy, readable []string
+	for _, c := range candidates {
+		var obj map[string]any
+		if err := json.Unmarshal(c.Content, &obj); err != nil {
+			continue
+		}
+		for _, ref := range findReferences(obj) {
+			if ref.id == req.ID && ref.resourceName == req.ResourceName {
+				referencedBy = append(referencedBy, c.Type+" "+c.ID)
+				apiFamily, _ := svc.resourceFamily(c.Type)
+				readReq := readRequest(req, apiFamily, c.Type, c.ID)
+				if takeDecision(svc.ruleEngine, readReq, token, repo.NewTMFObject(c.ID, c.Type, "", "", c.Content)) == nil {
+					readable = append(readable, c.Type+" "+c.ID)
+				}
+				break
+			}
+		}
+	}
+	if len(referencedBy) == 0 {
+		return nil
+	}
+
+	if mode == IntegrityWarn {
+		slog.Warn("Deleting referenced object", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID),
+			slog.Any("referencedBy", referencedBy))
+		return nil
+	}
+
+	reported := slices.Clip(readable[:min(len(readable), maxReferencesReported)])
+	if others := len(referencedBy) - len(reported); others > 0 && len(reported) == 0 {
+		reported = []string{fmt.Sprintf("%d objects", others)}
+	} else if others > 0 {
+		reported = append(reported, fmt.Sprintf("and %d more", others))
+	}
+	err = errl.Errorf("%s %s is referenced by %s", req.ResourceName, req.ID, strings.Join(reported, ", "))
+	apiErr := NewApiError("409", "Conflict", err.Error(), fmt.Sprintf("%d", http.StatusConflict), "")
+	slog.Error("Delete rejected by integrity checks", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID), slog.Int("referencedBy", len(referencedBy)))
+	return &Response{StatusCode: http.StatusConflict, Body: apiErr}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hesusruiz/isbetmf/pdp"
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+func TestParseIntegrityPolicy(t *testing.T) {
+	policy, err := ParseIntegrityPolicy("warn, category=strict ,organization=off")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for resourceName, mode := range map[string]IntegrityMode{
+		"category":             IntegrityStrict,
+		"organization":         IntegrityOff,
+		"productSpecification": IntegrityWarn,
+	} {
+		if policy.Mode(resourceName) != mode {
+			t.Errorf("%s: expected %s, got %s", resourceName, mode, policy.Mode(resourceName))
+		}
+	}
+
+	if _, err := ParseIntegrityPolicy("category=block"); err == nil {
+		t.Fatalf("expected error for invalid mode")
+	}
+}
+
+func TestReferenceIntegrity(t *testing.T) {
+	s := newTestService(t)
+	policy, _ := ParseIntegrityPolicy("strict,productSpecification=warn")
+	s.SetIntegrity(policy)
+
+	create := func(resourceName string, obj map[string]any) *Response {
+		b, _ := json.Marshal(obj)
+		return s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil))
+	}
+
+	if resp := create("category", map[string]any{"id": "urn:ngsi-ld:category:1", "name": "Storage"}); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create category: expected 201, got %d", resp.StatusCode)
+	}
+
+	// References to missing categories are rejected, and to missing specifications only logged
+	offering := map[string]any{
+		"id":   "urn:ngsi-ld:product-offering:1",
+		"name": "Cloud storage",
+		"category": []any{
+			map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1", "href": "/tmf-api/productCatalogManagement/v5/category/urn:ngsi-ld:category:1"},
+			map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:2"},
+		},
+		"productSpecification": map[string]any{"@type": "ProductSpecificationRef", "id": "urn:ngsi-ld:product-specification:1"},
+	}
+	resp := create("productOffering", offering)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("create offering: expected 400, got %d", resp.StatusCode)
+	}
+	if details := resp.Body.(*ApiError).Details; len(details) != 1 || details[0].Pointer != "/category/1" {
+		t.Fatalf("create offering: unexpected problems %+v", details)
+	}
+
+	// The href must correspond to the id
+	offering["category"] = []any{map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1", "href": "/category/urn:ngsi-ld:category:3"}}
+	if resp := create("productOffering", offering); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("create offering: expected 400 for wrong href, got %d", resp.StatusCode)
+	}
+
+	offering["category"] = []any{map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1"}}
+	if resp := create("productOffering", offering); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create offering: expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+
+	// Referenced objects can not be deleted, and the referencing objects which the caller can not read are only counted
+	private, _ := json.Marshal(map[string]any{"id": "urn:ngsi-ld:product-offering:2", "category": offering["category"]})
+	if err := s.createObject(repo.NewTMFObject("urn:ngsi-ld:product-offering:2", "productOffering", "1.0", "", private)); err != nil {
+		t.Fatal(err)
+	}
+	resp = s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil))
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("delete category: expected 409, got %d", resp.StatusCode)
+	}
+	if msg := resp.Body.(*ApiError).Message; !strings.Contains(msg, "productOffering urn:ngsi-ld:product-offering:1, and 1 more") || strings.Contains(msg, "product-offering:2") {
+		t.Fatalf("delete category: unexpected message %q", msg)
+	}
+	if err := s.deleteObject("urn:ngsi-ld:product-offering:2", "productOffering"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Until the reference is removed
+	b, _ := json.Marshal(map[string]any{"version": "1.1", "category": []any{}})
+	if resp := s.UpdateGenericObject(newReq("PATCH", "UPDATE", "productCatalogManagement", "productOffering", "urn:ngsi-ld:product-offering:1", b, nil)); resp.StatusCode != http.StatusOK {
+		t.Fatalf("update offering: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	resp = s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil))
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete category: expected 204, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+}
+
+func TestReferencesCheckedAfterAuthorization(t *testing.T) {
+	s := newTestService(t)
+	policy, _ := ParseIntegrityPolicy("strict")
+	s.SetIntegrity(policy)
+
+	policyFile := filepath.Join(t.TempDir(), "policies.star")
+	os.WriteFile(policyFile, []byte("def authorize():\n    return input.request.action != \"CREATE\"\n"), 0o644)
+	ruleEngine, err := pdp.NewPDP(&pdp.Config{PolicyFileName: policyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ruleEngine = ruleEngine
+
+	// A caller who can not create the object does not learn whether the objects referenced exist
+	b, _ := json.Marshal(map[string]any{
+		"name":     "Cloud storage",
+		"category": []any{map[string]any{"@type": "CategoryRef", "id": "urn:ngsi-ld:category:1"}},
+	})
+	resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "productOffering", "", b, nil))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("create offering: expected 403, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+}
+
+func TestDeleteChecksTargetBeforeReferences(t *testing.T) {
+	s := newTestService(t)
+	policy, _ := ParseIntegrityPolicy("strict")
+	s.SetIntegrity(policy)
+
+	b, _ := json.Marshal(map[string]any{"id": "urn:ngsi-ld:category:1", "name": "Storage"})
+	if resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "category", "", b, nil)); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create category: expected 201, got %d", resp.StatusCode)
+	}
+
+	// Only the latest version of the objects counts: the offering does not reference the category any more
+	old := []byte(`{"id":"urn:ngsi-ld:product-offering:1","category":[{"@type":"CategoryRef","id":"urn:ngsi-ld:category:1"}]}`)
+	s.createObject(repo.NewTMFObject("urn:ngsi-ld:product-offering:1", "productOffering", "1.0", "", old))
+	s.createObject(repo.NewTMFObject("urn:ngsi-ld:product-offering:1", "productOffering", "1.1", "", []byte(`{"id":"urn:ngsi-ld:product-offering:1"}`)))
+
+	// A missing object is not found, and a caller who can not delete the object gets no information about its references
+	if resp := s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", "category", "urn:ngsi-ld:category:2", nil, nil)); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("delete missing category: expected 404, got %d", resp.StatusCode)
+	}
+	policyFile := filepath.Join(t.TempDir(), "policies.star")
+	os.WriteFile(policyFile, []byte("def authorize():\n    return input.request.action != \"DELETE\"\n"), 0o644)
+	ruleEngine, err := pdp.NewPDP(&pdp.Config{PolicyFileName: policyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ruleEngine = ruleEngine
+	if resp := s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("delete category: expected 403, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+
+	s.ruleEngine = nil
+	if resp := s.DeleteGenericObject(newReq("DELETE", "DELETE", "productCatalogManagement", "category", "urn:ngsi-ld:category:1", nil, nil)); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete category: expected 204, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+}
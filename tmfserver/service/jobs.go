@@ -0,0 +1,798 @@
+package service
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+// Import and export jobs (TMF asynchronous operations) are stored as TMF objects of type importJob and
+// exportJob, so they are retrieved, listed and polled with the generic operations. They are processed
+// in the background, updating the status of the job object when they start and when they finish.
+
+// States of a job (JobStateType in the TMF specs)
+const (
+	JobNotStarted = "Not Started"
+	JobRunning    = "Running"
+	JobSucceeded  = "Succeeded"
+	JobFailed     = "Failed"
+)
+
+// Formats of the files of the jobs. JSON files contain an array of objects, and NDJSON files one object per line.
+const (
+	ContentTypeJSON   = "application/json"
+	ContentTypeNDJSON = "application/x-ndjson"
+)
+
+// exportPageSize is the number of objects retrieved at a time by export jobs
+const exportPageSize = 100
+
+// maxImportSize limits the size of the remote files retrieved by import jobs
+const maxImportSize = 256 << 20
+
+// importTimeout limits the time to retrieve the remote files of import jobs
+const importTimeout = 5 * time.Minute
+
+// Results of the items of an import job, written in its log
+const (
+	itemCreated = "created"
+	itemUpdated = "updated"
+	itemSkipped = "skipped"
+	itemFailed  = "failed"
+)
+
+// jobLogEntry is a line of the log of a job, in NDJSON format. Import jobs write one for each item of the
+// file, numbered from 1, and all jobs write one without item when they fail.
+type jobLogEntry struct {
+	Item         int    `json:"item,omitempty"`
+	ID           string `json:"id,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Result       string `json:"result"`
+	Message      string `json:"message,omitempty"`
+}
+
+// SetJobsDir sets the directory where export jobs write the exported objects and all jobs their logs.
+// Import and export jobs are rejected until it is set.
+func (svc *Service) SetJobsDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errl.Errorf("failed to create jobs directory: %w", err)
+	}
+	svc.jobsDir = dir
+	return nil
+}
+
+// IsJobResource returns true for the resources of import and export jobs, which are created and deleted
+// by the dedicated operations of the service instead of the generic ones.
+func IsJobResource(resourceName string) bool {
+	return resourceName == "exportJob" || resourceName == "importJob"
+}
+
+// jobPath returns the path of the API of a job, with an optional suffix like "/content"
+func jobPath(apiFamily, resourceName, id, suffix string) string {
+	return fmt.Sprintf("/tmf-api/%s/v5/%s/%s%s", apiFamily, resourceName, id, suffix)
+}
+
+// jobFileName returns the path of a file of a job in the jobs directory, named after the id of the job with
+// the colons replaced. The ids come from the URLs of the requests, so the ids with path separators or
+// dot-dot elements are rejected, and the path must be inside the jobs directory.
+func (svc *Service) jobFileName(id, ext string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) || strings.Contains(id, "..") {
+		return "", errl.Errorf("invalid job id %q", id)
+	}
+	name := filepath.Join(svc.jobsDir, strings.ReplaceAll(id, ":", "_")+ext)
+	if rel, err := filepath.Rel(svc.jobsDir, name); err != nil || rel != filepath.Base(name) {
+		return "", errl.Errorf("invalid job id %q", id)
+	}
+	return name, nil
+}
+
+// contentExtension returns the extension of the file where an export job writes the objects
+func contentExtension(contentType string) string {
+	if contentType == ContentTypeNDJSON {
+		return ".ndjson"
+	}
+	return ".json"
+}
+
+// rootResource returns the name of the resource in the path of a job, which can be just the name or the
+// URL of the resource, like "/tmf-api/productCatalogManagement/v5/productOffering".
+func rootResource(path string) string {
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+	path = strings.TrimSuffix(path, "/")
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// jobRun is a job being processed in the background
+type jobRun struct {
+	svc *Service
+
+	// The request which created the job, with the ID of the job, and the access token of the caller,
+	// used to perform the operations of the job
+	req         *Request
+	accessToken string
+
+	// expiry is the expiration of the access token, after which the job can not continue, if it has one
+	expiry time.Time
+
+	job     map[string]any
+	version int
+	log     *os.File
+}
+
+// CreateExportJob creates a job which exports to a file the objects of the resource in the path of the job,
+// filtered with the TMF630 query of the job. The file is written in the format specified in contentType,
+// either JSON (the default) or NDJSON, and is available at the url of the job when it succeeds.
+func (svc *Service) CreateExportJob(req *Request) *Response {
+	return svc.createJob(req, svc.prepareExportJob, (*jobRun).export)
+}
+
+// prepareExportJob checks the properties of an export job, and sets the url of the file
+func (svc *Service) prepareExportJob(req *Request, job map[string]any) *Response {
+	path, _ := job["path"].(string)
+	if path == "" {
+		return badRequestResponse(errl.Errorf("path is required, with the resource to export"))
+	}
+	if resp := svc.CheckRoute(req.APIfamily, rootResource(path)); resp != nil {
+		return badRequestResponse(errl.Errorf("invalid path %s: resource %s not found in API family %s", path, rootResource(path), req.APIfamily))
+	}
+
+	query, _ := job["query"].(string)
+	if _, err := url.ParseQuery(query); err != nil {
+		return badRequestResponse(errl.Errorf("invalid query: %w", err))
+	}
+
+	switch contentType, _ := job["contentType"].(string); contentType {
+	case "":
+		job["contentType"] = ContentTypeJSON
+	case ContentTypeJSON, ContentTypeNDJSON:
+	default:
+		return badRequestResponse(errl.Errorf("unsupported contentType %s, expected %s or %s", contentType, ContentTypeJSON, ContentTypeNDJSON))
+	}
+
+	// The file is served by this server, wherever the caller wanted it to be
+	job["url"] = jobPath(req.APIfamily, req.ResourceName, job["id"].(string), "/content")
+	return nil
+}
+
+// CreateImportJob creates a job which imports the objects in the file at the url of the job, in JSON or NDJSON
+// format. The url is either an absolute http(s) URL, subject to the same rules as the callbacks of hub
+// subscriptions, or the url of an export job of this server.
+// Objects which do not exist are created, and existing objects are updated if the version in the file is
+// greater. The objects belong to the resource in the path of the job or, if there is no path, to the
+// resource in their @type. The result of each object is written to the errorLog of the job.
+func (svc *Service) CreateImportJob(req *Request) *Response {
+	return svc.createJob(req, svc.prepareImportJob, (*jobRun).importObjects)
+}
+
+// prepareImportJob checks the properties of an import job
+func (svc *Service) prepareImportJob(req *Request, job map[string]any) *Response {
+	source, _ := job["url"].(string)
+	if source == "" {
+		return badRequestResponse(errl.Errorf("url is required, with the file to import"))
+	}
+	if !strings.HasPrefix(source, "/tmf-api/") && svc.callbackPolicy != nil {
+		if err := svc.callbackPolicy.ValidateURL(source); err != nil {
+			return badRequestResponse(errl.Errorf("invalid url: %w", err))
+		}
+	}
+
+	if path, _ := job["path"].(string); path != "" {
+		if resp := svc.CheckRoute(req.APIfamily, rootResource(path)); resp != nil {
+			return badRequestResponse(errl.Errorf("invalid path %s: resource %s not found in API family %s", path, rootResource(path), req.APIfamily))
+		}
+	}
+
+	switch contentType, _ := job["contentType"].(string); contentType {
+	case "", ContentTypeJSON, ContentTypeNDJSON:
+	default:
+		return badRequestResponse(errl.Errorf("unsupported contentType %s, expected %s or %s", contentType, ContentTypeJSON, ContentTypeNDJSON))
+	}
+	return nil
+}
+
+// createJob creates the object of an import or export job, and starts processing it in the background.
+// The properties managed by the server are set before calling prepare, which checks the rest.
+func (svc *Service) createJob(req *Request, prepare func(*Request, map[string]any) *Response, process func(*jobRun) error) *Response {
+	slog.Debug("createJob called", slog.String("apiFamily", req.APIfamily), slog.String("resourceName", req.ResourceName))
+
+	// The token is needed by the job, before it is replaced for testing by extractCallerInfo
+	accessToken := req.AccessToken
+
+	// Authentication: process the AccessToken to extract caller info from its claims in the payload
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return unauthorizedResponse(err)
+	}
+
+	// This operation can not be done without authentication
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	if svc.jobsDir == "" {
+		return internalErrorResponse(errl.Errorf("jobs are not enabled in this server"))
+	}
+
+	var job map[string]any
+	if err := json.Unmarshal(req.Body, &job); err != nil {
+		return badRequestResponse(errl.Errorf("failed to bind request body: %w", err))
+	}
+
+	// The @type of the jobs is ExportJob or ImportJob
+	jobType := strings.ToUpper(req.ResourceName[:1]) + req.ResourceName[1:]
+	if typeVal, ok := job["@type"].(string); ok && !strings.EqualFold(typeVal, req.ResourceName) {
+		return badRequestResponse(errl.Errorf("@type mismatch: expected %s, got %s", jobType, typeVal))
+	}
+
+	// The ids of jobs are always generated, because they are used to name files
+	id := fmt.Sprintf("urn:ngsi-ld:%s:%s", ToKebabCase(req.ResourceName), uuid.NewString())
+	now := time.Now().Format(time.RFC3339Nano)
+	job["id"] = id
+	job["href"] = jobPath(req.APIfamily, req.ResourceName, id, "")
+	job["@type"] = jobType
+	job["version"] = "1.0"
+	job["lastUpdate"] = now
+	job["creationDate"] = now
+	job["status"] = JobNotStarted
+	job["errorLog"] = jobPath(req.APIfamily, req.ResourceName, id, "/errorLog")
+	delete(job, "completionDate")
+
+	// The owner of the job is the caller, like for any other object
+	if err := setSellerAndBuyerInfo(job, req.AuthUser.OrganizationIdentifier); err != nil {
+		return internalErrorResponse(errl.Errorf("failed to add Seller and Buyer info: %w", err))
+	}
+
+	if resp := prepare(req, job); resp != nil {
+		return resp
+	}
+
+	// Check the job against the schema of the resource in the OpenAPI spec
+	if resp := svc.validateBody(req, job, false); resp != nil {
+		return resp
+	}
+
+	content, err := json.Marshal(job)
+	if err != nil {
+		return internalErrorResponse(errl.Errorf("failed to marshal job: %w", err))
+	}
+	obj := repo.NewTMFObject(id, req.ResourceName, "1.0", now, content)
+
+	if err := takeDecision(svc.ruleEngine, req, token, obj); err != nil {
+		apiErr := NewApiError("403", "Forbidden", errl.Errorf("user not authorized: %w", err).Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		slog.Error("Unauthorized request")
+		return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	if err := svc.createObject(obj); err != nil {
+		return internalErrorResponse(errl.Errorf("failed to create job: %w", err))
+	}
+	slog.Info("Job created", slog.String("id", id), slog.String("resourceName", req.ResourceName))
+
+	jobReq := *req
+	jobReq.ID = id
+	run := &jobRun{svc: svc, req: &jobReq, accessToken: accessToken, job: job}
+	// The fake claims used for testing, when there is no token, do not expire
+	if exp, err := jwt.MapClaims(token).GetExpirationTime(); err == nil && exp != nil && accessToken != "" {
+		run.expiry = exp.Time
+	}
+
+	eventType := toEventType(req.ResourceName, "CreateEvent")
+	svc.publishEvent(req.APIfamily, eventType, buildEventPayload(&jobReq, eventType, job))
+
+	// The job object is copied, so it is not modified by the job while the response is sent
+	response := make(map[string]any, len(job))
+	for k, v := range job {
+		response[k] = v
+	}
+
+	svc.jobs.Add(1)
+	go func() {
+		defer svc.jobs.Done()
+		run.run(process)
+	}()
+
+	return &Response{
+		StatusCode: http.StatusCreated,
+		Headers:    map[string]string{"Location": job["href"].(string)},
+		Body:       response,
+	}
+}
+
+// run processes the job, updating its status when it starts and when it finishes
+func (r *jobRun) run(process func(*jobRun) error) {
+	logName, err := r.svc.jobFileName(r.req.ID, ".log")
+	if err != nil {
+		slog.Error("Invalid job", slog.String("id", r.req.ID), slog.Any("error", err))
+		r.setStatus(JobFailed)
+		return
+	}
+	log, err := os.Create(logName)
+	if err != nil {
+		slog.Error("Failed to create job log", slog.String("id", r.req.ID), slog.Any("error", err))
+		r.setStatus(JobFailed)
+		return
+	}
+	r.log = log
+	defer r.log.Close()
+
+	r.setStatus(JobRunning)
+
+	if err := process(r); err != nil {
+		slog.Error("Job failed", slog.String("id", r.req.ID), slog.Any("error", err))
+		r.writeLog(jobLogEntry{Result: itemFailed, Message: err.Error()})
+		r.setStatus(JobFailed)
+		return
+	}
+
+	slog.Info("Job succeeded", slog.String("id", r.req.ID))
+	r.setStatus(JobSucceeded)
+}
+
+// setStatus updates the status of the job object, and notifies the change
+func (r *jobRun) setStatus(status string) {
+	now := time.Now().Format(time.RFC3339Nano)
+	r.version++
+	r.job["status"] = status
+	r.job["version"] = "1." + strconv.Itoa(r.version)
+	r.job["lastUpdate"] = now
+	if status == JobSucceeded || status == JobFailed {
+		r.job["completionDate"] = now
+	}
+
+	content, err := json.Marshal(r.job)
+	if err == nil {
+		err = r.svc.updateObject(repo.NewTMFObject(r.req.ID, r.req.ResourceName, r.job["version"].(string), now, content))
+	}
+	if err != nil {
+		slog.Error("Failed to update job status", slog.String("id", r.req.ID), slog.String("status", status), slog.Any("error", err))
+		return
+	}
+
+	eventType := toEventType(r.req.ResourceName, "StateChangeEvent")
+	r.svc.publishEvent(r.req.APIfamily, eventType, buildEventPayload(r.req, eventType, r.job))
+}
+
+// checkToken returns an error if the access token of the caller expired. The operations of the job are
+// performed with the token, so a job which takes longer than its lifetime fails and must be split or created again.
+func (r *jobRun) checkToken() error {
+	if !r.expiry.IsZero() && time.Now().After(r.expiry) {
+		return errl.Errorf("the access token of the job expired at %s", r.expiry.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// writeLog writes an entry in the log of the job
+func (r *jobRun) writeLog(entry jobLogEntry) {
+	line, _ := json.Marshal(entry)
+	if _, err := r.log.Write(append(line, '\n')); err != nil {
+		slog.Error("Failed to write job log", slog.String("id", r.req.ID), slog.Any("error", err))
+	}
+}
+
+// export writes the objects selected by the job to its file, retrieving them a page at a time with
+// the permissions of the caller. The file is renamed when complete, so it is never served partially.
+func (r *jobRun) export() error {
+	resourceName := rootResource(r.job["path"].(string))
+	contentType := r.job["contentType"].(string)
+
+	query, _ := r.job["query"].(string)
+	queryParams, _ := url.ParseQuery(query)
+	if queryParams.Get("sort") == "" {
+		// Pages must be retrieved always in the same order
+		queryParams.Set("sort", "id")
+	}
+
+	fileName, err := r.svc.jobFileName(r.req.ID, contentExtension(contentType))
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(fileName + ".tmp")
+	if err != nil {
+		return errl.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if contentType == ContentTypeJSON {
+		w.WriteString("[")
+	}
+
+	count := 0
+	for offset := 0; ; offset += exportPageSize {
+		if err := r.checkToken(); err != nil {
+			return err
+		}
+		queryParams.Set("offset", strconv.Itoa(offset))
+		queryParams.Set("limit", strconv.Itoa(exportPageSize))
+		resp := r.svc.ListGenericObjects(&Request{
+			Method:       http.MethodGet,
+			Action:       HttpMethodAliases[http.MethodGet],
+			APIfamily:    r.req.APIfamily,
+			ResourceName: resourceName,
+			QueryParams:  queryParams,
+			AccessToken:  r.accessToken,
+		})
+		if resp.StatusCode != http.StatusOK {
+			return errl.Errorf("failed to list %s: %s", resourceName, responseMessage(resp))
+		}
+
+		items, _ := resp.Body.([]map[string]any)
+		for _, item := range items {
+			b, err := json.Marshal(item)
+			if err != nil {
+				return errl.Errorf("failed to marshal object: %w", err)
+			}
+			if contentType == ContentTypeJSON && count > 0 {
+				w.WriteString(",")
+			}
+			w.WriteString("\n")
+			w.Write(b)
+			count++
+		}
+		if len(items) < exportPageSize {
+			break
+		}
+	}
+
+	if contentType == ContentTypeJSON {
+		w.WriteString("\n]")
+	}
+	w.WriteString("\n")
+
+	if err := w.Flush(); err != nil {
+		return errl.Errorf("failed to write file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return errl.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(f.Name(), fileName); err != nil {
+		return errl.Errorf("failed to rename file: %w", err)
+	}
+
+	slog.Info("Objects exported", slog.String("id", r.req.ID), slog.String("resourceName", resourceName), slog.Int("count", count))
+	return nil
+}
+
+// importObjects creates or updates the objects in the file of the job, writing the result of each one to
+// the log. The job fails if the file can not be processed or if any object fails.
+func (r *jobRun) importObjects() error {
+	source, err := r.svc.openImportSource(r.job["url"].(string), r.accessToken)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	resourceName := ""
+	if path, _ := r.job["path"].(string); path != "" {
+		resourceName = rootResource(path)
+	}
+
+	failed := 0
+	item := 0
+	var expired error
+	err = decodeObjects(source, func(obj map[string]any) {
+		if expired != nil {
+			return
+		}
+		if expired = r.checkToken(); expired != nil {
+			return
+		}
+		item++
+		entry := r.importObject(resourceName, obj)
+		entry.Item = item
+		if entry.Result == itemFailed {
+			failed++
+		}
+		r.writeLog(entry)
+	})
+	if err != nil {
+		return errl.Errorf("failed to read item %d: %w", item+1, err)
+	}
+	if expired != nil {
+		return errl.Errorf("stopped after item %d: %w", item, expired)
+	}
+
+	slog.Info("Objects imported", slog.String("id", r.req.ID), slog.Int("count", item), slog.Int("failed", failed))
+	if failed > 0 {
+		return errl.Errorf("%d of %d objects could not be imported", failed, item)
+	}
+	return nil
+}
+
+// importObject creates or updates an object with the permissions of the caller, returning the result
+func (r *jobRun) importObject(resourceName string, obj map[string]any) jobLogEntry {
+	id, _ := obj["id"].(string)
+	if resourceName == "" {
+		if resourceName = resourceOfType(obj); resourceName == "" {
+			return jobLogEntry{ID: id, Result: itemFailed, Message: "missing @type"}
+		}
+	}
+	entry := jobLogEntry{ID: id, ResourceName: resourceName}
+
+	// The ids of jobs name their files, so jobs are only created by the job operations
+	if IsJobResource(resourceName) {
+		entry.Result, entry.Message = itemFailed, fmt.Sprintf("%s can not be imported", resourceName)
+		return entry
+	}
+
+	if resp := r.svc.CheckRoute(r.req.APIfamily, resourceName); resp != nil {
+		entry.Result, entry.Message = itemFailed, responseMessage(resp)
+		return entry
+	}
+
+	var existing *repo.TMFObject
+	if id != "" {
+		var err error
+		if existing, err = r.svc.getObject(id, resourceName); err != nil {
+			entry.Result, entry.Message = itemFailed, err.Error()
+			return entry
+		}
+	}
+
+	body, err := json.Marshal(obj)
+	if err != nil {
+		entry.Result, entry.Message = itemFailed, err.Error()
+		return entry
+	}
+
+	req := &Request{
+		APIfamily:    r.req.APIfamily,
+		ResourceName: resourceName,
+		Body:         body,
+		AccessToken:  r.accessToken,
+	}
+
+	var resp *Response
+	if existing == nil {
+		req.Method = http.MethodPost
+		req.Action = HttpMethodAliases[req.Method]
+		resp = r.svc.CreateGenericObject(req)
+		entry.Result = itemCreated
+	} else {
+		// Objects already imported, or modified in this server after being exported, are left alone
+		if version, _ := obj["version"].(string); compareVersions(version, existing.Version) <= 0 {
+			entry.Result, entry.Message = itemSkipped, fmt.Sprintf("version %s is not greater than existing version %s", version, existing.Version)
+			return entry
+		}
+		req.Method = http.MethodPatch
+		req.Action = HttpMethodAliases[req.Method]
+		req.ID = id
+		resp = r.svc.UpdateGenericObject(req)
+		entry.Result = itemUpdated
+	}
+
+	if resp.StatusCode >= 300 {
+		entry.Result, entry.Message = itemFailed, responseMessage(resp)
+	} else if created, ok := resp.Body.(map[string]any); ok {
+		entry.ID, _ = created["id"].(string)
+	}
+	return entry
+}
+
+// compareVersions compares two versions of an object, like "1.0" and "10.2", returning -1, 0 or +1.
+// The components separated by dots are compared as numbers, and as strings if they are not numbers.
+// The missing components are zero, so "1" and "1.0" are the same version.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		x, y := "0", "0"
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		xn, errX := strconv.Atoi(x)
+		yn, errY := strconv.Atoi(y)
+		var c int
+		if errX == nil && errY == nil {
+			c = cmp.Compare(xn, yn)
+		} else {
+			c = strings.Compare(x, y)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// openImportSource opens the file to import: the file of an export job of this server, which the caller
+// must be allowed to read, or a remote file
+func (svc *Service) openImportSource(source, accessToken string) (io.ReadCloser, error) {
+	if strings.HasPrefix(source, "/tmf-api/") {
+		// The url of an export job is /tmf-api/{apiFamily}/v5/exportJob/{id}/content
+		parts := strings.Split(source, "/")
+		if len(parts) != 7 || parts[4] != "exportJob" || parts[6] != "content" {
+			return nil, errl.Errorf("url %s is not the content of an export job", source)
+		}
+		id, _ := url.PathUnescape(parts[5])
+		fileName, _, resp := svc.JobFile(&Request{
+			Method:       http.MethodGet,
+			Action:       HttpMethodAliases[http.MethodGet],
+			APIfamily:    parts[2],
+			ResourceName: "exportJob",
+			ID:           id,
+			AccessToken:  accessToken,
+		}, true)
+		if resp != nil {
+			return nil, errl.Errorf("export job %s: %s", id, responseMessage(resp))
+		}
+		return os.Open(fileName)
+	}
+
+	client := &http.Client{Timeout: importTimeout}
+	if svc.callbackPolicy != nil {
+		client = notifications.NewPolicyHTTPClient(importTimeout, svc.callbackPolicy)
+	}
+	resp, err := client.Get(source)
+	if err != nil {
+		return nil, errl.Errorf("failed to retrieve %s: %w", source, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errl.Errorf("failed to retrieve %s: status %d", source, resp.StatusCode)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(resp.Body, maxImportSize), resp.Body}, nil
+}
+
+// decodeObjects calls fn for each object in a JSON array or in a stream of JSON objects, like NDJSON
+func decodeObjects(r io.Reader, fn func(map[string]any)) error {
+	br := bufio.NewReader(r)
+	dec := json.NewDecoder(br)
+
+	// Skip the white space to find if the content is an array
+	var first byte
+	for {
+		b, err := br.Peek(1)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if b[0] != ' ' && b[0] != '\t' && b[0] != '\r' && b[0] != '\n' {
+			first = b[0]
+			break
+		}
+		br.ReadByte()
+	}
+
+	if first == '[' {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		for dec.More() {
+			var obj map[string]any
+			if err := dec.Decode(&obj); err != nil {
+				return err
+			}
+			fn(obj)
+		}
+		_, err := dec.Token()
+		return err
+	}
+
+	for {
+		var obj map[string]any
+		if err := dec.Decode(&obj); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		fn(obj)
+	}
+}
+
+// responseMessage returns the message of an error response
+func responseMessage(resp *Response) string {
+	if apiErr, ok := resp.Body.(*ApiError); ok {
+		return apiErr.Message
+	}
+	return http.StatusText(resp.StatusCode)
+}
+
+// JobFile returns the name and the format of a file of a job: the objects exported by an export job if
+// content is true, or the log of the job otherwise.
+// The files contain the objects exported and the results of the operations, so they are only served to
+// the callers which the PDP allows to read the object of the job, like its owner.
+func (svc *Service) JobFile(req *Request, content bool) (string, string, *Response) {
+	id, resourceName := req.ID, req.ResourceName
+	if !IsJobResource(resourceName) || (content && resourceName != "exportJob") {
+		return "", "", notFoundResponse(errl.Errorf("%s does not have files", resourceName))
+	}
+	logName, err := svc.jobFileName(id, ".log")
+	if err != nil {
+		return "", "", notFoundResponse(err)
+	}
+
+	// Authentication: process the AccessToken to extract caller info from its claims in the payload
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return "", "", unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return "", "", unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	obj, err := svc.getObject(id, resourceName)
+	if err != nil {
+		return "", "", internalErrorResponse(errl.Errorf("failed to get job: %w", err))
+	}
+	if obj == nil {
+		return "", "", notFoundResponse(errl.Errorf("job not found"))
+	}
+
+	if err := takeDecision(svc.ruleEngine, req, token, obj); err != nil {
+		apiErr := NewApiError("403", "Forbidden", errl.Errorf("user not authorized: %w", err).Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		slog.Error("Unauthorized request", slog.Any("error", err))
+		return "", "", &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	if !content {
+		return logName, ContentTypeNDJSON, nil
+	}
+
+	var job map[string]any
+	if err := json.Unmarshal(obj.Content, &job); err != nil {
+		return "", "", internalErrorResponse(errl.Errorf("failed to unmarshal job: %w", err))
+	}
+	if status, _ := job["status"].(string); status != JobSucceeded {
+		return "", "", notFoundResponse(errl.Errorf("content not available, the status of the job is %s", status))
+	}
+	contentType, _ := job["contentType"].(string)
+	fileName, err := svc.jobFileName(id, contentExtension(contentType))
+	if err != nil {
+		return "", "", notFoundResponse(err)
+	}
+	return fileName, contentType, nil
+}
+
+// DeleteJob deletes an import or export job, and its files. The job must exist and the PDP must allow
+// the caller to delete it before any file is touched.
+func (svc *Service) DeleteJob(req *Request) *Response {
+	var fileNames []string
+	for _, ext := range []string{".log", ".json", ".ndjson"} {
+		fileName, err := svc.jobFileName(req.ID, ext)
+		if err != nil {
+			return notFoundResponse(err)
+		}
+		fileNames = append(fileNames, fileName)
+	}
+
+	// The files are removed only if the caller can delete the job, which must exist
+	resp := svc.DeleteGenericObject(req)
+	if resp.StatusCode != http.StatusNoContent {
+		return resp
+	}
+
+	for _, fileName := range fileNames {
+		if err := os.Remove(fileName); err != nil && !errors.Is(err, os.ErrNotExist) {
+			slog.Error("Failed to remove job file", slog.String("id", req.ID), slog.Any("error", err))
+		}
+	}
+	return resp
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
+	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
+)
+
+const catalogAPI = "productCatalogManagement"
+
+// createJob creates a job, waits until it finishes and returns its final state
+func createJob(t *testing.T, s *Service, resourceName string, job map[string]any) map[string]any {
+	t.Helper()
+
+	b, _ := json.Marshal(job)
+	req := newReq("POST", "CREATE", catalogAPI, resourceName, "", b, nil)
+	var resp *Response
+	if resourceName == "exportJob" {
+		resp = s.CreateExportJob(req)
+	} else {
+		resp = s.CreateImportJob(req)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create %s: expected 201, got %d: %+v", resourceName, resp.StatusCode, resp.Body)
+	}
+	created := resp.Body.(map[string]any)
+	if created["status"] != JobNotStarted {
+		t.Fatalf("create %s: unexpected status %v", resourceName, created["status"])
+	}
+
+	s.jobs.Wait()
+
+	resp = s.GetGenericObject(newReq("GET", "READ", catalogAPI, resourceName, created["id"].(string), nil, nil))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("get %s: expected 200, got %d", resourceName, resp.StatusCode)
+	}
+	return resp.Body.(map[string]any)
+}
+
+// readJobLog returns the entries of the log of a job
+func readJobLog(t *testing.T, s *Service, resourceName, id string) []jobLogEntry {
+	t.Helper()
+
+	fileName, _, resp := s.JobFile(&Request{ResourceName: resourceName, ID: id}, false)
+	if resp != nil {
+		t.Fatalf("log of %s: %+v", id, resp.Body)
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("log of %s: %v", id, err)
+	}
+	var entries []jobLogEntry
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry jobLogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("log of %s: %v", id, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestExportImportJobs(t *testing.T) {
+	specs, err := openapi.LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+	s := newTestService(t)
+	s.SetOpenAPI(specs, openapi.ModeEnforce)
+	if err := s.SetJobsDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, status := range []string{"Launched", "Launched", "Retired"} {
+		b, _ := json.Marshal(map[string]any{"@type": "ProductOffering", "name": "Offering", "lifecycleStatus": status})
+		resp := s.CreateGenericObject(newReq("POST", "CREATE", catalogAPI, "productOffering", "", b, nil))
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create offering: expected 201, got %d", resp.StatusCode)
+		}
+		ids = append(ids, resp.Body.(map[string]any)["id"].(string))
+	}
+
+	// Export the launched offerings
+	export := createJob(t, s, "exportJob", map[string]any{
+		"path":        "/tmf-api/productCatalogManagement/v5/productOffering",
+		"query":       "lifecycleStatus=Launched",
+		"contentType": ContentTypeNDJSON,
+	})
+	if export["status"] != JobSucceeded || export["completionDate"] == nil {
+		t.Fatalf("export: unexpected job %v", export)
+	}
+	fileName, contentType, resp := s.JobFile(&Request{ResourceName: "exportJob", ID: export["id"].(string)}, true)
+	if resp != nil || contentType != ContentTypeNDJSON {
+		t.Fatalf("export: content not available: %v", resp)
+	}
+	data, _ := os.ReadFile(fileName)
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+		t.Fatalf("export: expected 2 objects, got %d", len(lines))
+	}
+
+	// Import the export after deleting one of the offerings: it is created again and the other is up to date
+	s.DeleteGenericObject(newReq("DELETE", "DELETE", catalogAPI, "productOffering", ids[0], nil, nil))
+	imp := createJob(t, s, "importJob", map[string]any{"url": export["url"]})
+	if imp["status"] != JobSucceeded {
+		t.Fatalf("import: unexpected job %v", imp)
+	}
+	results := map[string]string{}
+	for _, entry := range readJobLog(t, s, "importJob", imp["id"].(string)) {
+		results[entry.ID] = entry.Result
+	}
+	if results[ids[0]] != itemCreated || results[ids[1]] != itemSkipped {
+		t.Fatalf("import: unexpected results %v", results)
+	}
+
+	// Import from a remote JSON file, with an object which does not belong to the API
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]any{
+			map[string]any{"@type": "ProductOffering", "id": ids[1], "version": "1.1", "name": "Renamed"},
+			map[string]any{"@type": "Organization", "name": "ACME"},
+		})
+	}))
+	defer remote.Close()
+	imp = createJob(t, s, "importJob", map[string]any{"url": remote.URL})
+	if imp["status"] != JobFailed {
+		t.Fatalf("import: expected failure, got %v", imp["status"])
+	}
+	entries := readJobLog(t, s, "importJob", imp["id"].(string))
+	if len(entries) != 3 || entries[0].Result != itemUpdated || entries[1].Result != itemFailed || entries[2].Item != 0 {
+		t.Fatalf("import: unexpected log %+v", entries)
+	}
+	resp = s.GetGenericObject(newReq("GET", "READ", catalogAPI, "productOffering", ids[1], nil, nil))
+	if resp.Body.(map[string]any)["name"] != "Renamed" {
+		t.Fatalf("import: object not updated: %v", resp.Body)
+	}
+
+	// Jobs with resources of other APIs are rejected
+	b, _ := json.Marshal(map[string]any{"path": "organization"})
+	if resp := s.CreateExportJob(newReq("POST", "CREATE", catalogAPI, "exportJob", "", b, url.Values{})); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("export: expected 400, got %d", resp.StatusCode)
+	}
+
+	// Deleting a job deletes its files
+	if resp := s.DeleteJob(newReq("DELETE", "DELETE", catalogAPI, "exportJob", export["id"].(string), nil, nil)); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("delete: expected 204, got %d", resp.StatusCode)
+	}
+	if _, err := os.Stat(fileName); !os.IsNotExist(err) {
+		t.Fatalf("delete: content not removed")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1", "1.0", 0},
+		{"1.1", "1.0", 1},
+		{"10.0", "9.0", 1},
+		{"1.2", "1.10", -1},
+		{"2.0-beta", "2.0-alpha", 1},
+		{"", "1.0", -1},
+	} {
+		if got := compareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestJobFileRequiresReadAccess(t *testing.T) {
+	s := newTestService(t)
+	if err := s.SetJobsDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	// A job of another organization, which the PDP does not allow the caller to read
+	s.createObject(repo.NewTMFObject("urn:ngsi-ld:export-job:1", "exportJob", "1.0", "",
+		[]byte(`{"id":"urn:ngsi-ld:export-job:1","status":"Succeeded","contentType":"application/json"}`)))
+
+	for _, content := range []bool{true, false} {
+		req := newReq("GET", "READ", "productCatalogManagement", "exportJob", "urn:ngsi-ld:export-job:1", nil, nil)
+		if _, _, resp := s.JobFile(req, content); resp == nil || resp.StatusCode != http.StatusForbidden {
+			t.Fatalf("file of job (content %v): expected 403, got %+v", content, resp)
+		}
+	}
+}
+
+func TestDeleteJobChecksIdAndOwner(t *testing.T) {
+	s := newTestService(t)
+	dir := filepath.Join(t.TempDir(), "jobs")
+	if err := s.SetJobsDir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ids with path elements never name a file outside the jobs directory
+	victim := filepath.Join(filepath.Dir(dir), "victim.json")
+	os.WriteFile(victim, []byte("{}"), 0o644)
+	for _, id := range []string{"../victim", `..\victim`, "a/b"} {
+		if resp := s.DeleteJob(newReq("DELETE", "DELETE", catalogAPI, "exportJob", id, nil, nil)); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("delete %q: expected 404, got %d", id, resp.StatusCode)
+		}
+	}
+	if _, err := os.Stat(victim); err != nil {
+		t.Fatalf("delete: file outside the jobs directory removed: %v", err)
+	}
+
+	// Jobs which do not exist, or which the caller can not delete, are not touched
+	logFile := filepath.Join(dir, "urn_ngsi-ld_export-job_1.log")
+	os.WriteFile(logFile, []byte("{}\n"), 0o644)
+	if resp := s.DeleteJob(newReq("DELETE", "DELETE", catalogAPI, "exportJob", "urn:ngsi-ld:export-job:1", nil, nil)); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("delete: expected 404 for a missing job, got %d", resp.StatusCode)
+	}
+	s.createObject(repo.NewTMFObject("urn:ngsi-ld:export-job:1", "exportJob", "1.0", "",
+		[]byte(`{"id":"urn:ngsi-ld:export-job:1","status":"Succeeded","contentType":"application/json"}`)))
+	if resp := s.DeleteJob(newReq("DELETE", "DELETE", catalogAPI, "exportJob", "urn:ngsi-ld:export-job:1", nil, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("delete: expected 403 for a job of another organization, got %d", resp.StatusCode)
+	}
+	if _, err := os.Stat(logFile); err != nil {
+		t.Fatalf("delete: log of the job removed: %v", err)
+	}
+}
+
+func TestJobsOnlyChangedByJobOperations(t *testing.T) {
+	s := newTestService(t)
+	if err := s.SetJobsDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	export := createJob(t, s, "exportJob", map[string]any{"path": "productOffering"})
+
+	b, _ := json.Marshal(map[string]any{"version": "2.0", "status": JobSucceeded, "url": "/etc/passwd"})
+	if resp := s.UpdateGenericObject(newReq("PATCH", "UPDATE", catalogAPI, "exportJob", export["id"].(string), b, nil)); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("update job: expected 400, got %d", resp.StatusCode)
+	}
+	b, _ = json.Marshal(map[string]any{"id": "../job", "path": "productOffering"})
+	if resp := s.CreateGenericObject(newReq("POST", "CREATE", catalogAPI, "exportJob", "", b, nil)); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("create job: expected 400, got %d", resp.StatusCode)
+	}
+
+	// Import files can not create jobs either
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]any{map[string]any{"@type": "ExportJob", "id": "../job", "path": "productOffering"}})
+	}))
+	defer remote.Close()
+	imp := createJob(t, s, "importJob", map[string]any{"url": remote.URL})
+	entries := readJobLog(t, s, "importJob", imp["id"].(string))
+	if imp["status"] != JobFailed || entries[0].Result != itemFailed {
+		t.Fatalf("import: expected the job to be rejected, got %v %+v", imp["status"], entries)
+	}
+
+	// Jobs stop when the token of the caller expires
+	run := &jobRun{expiry: time.Now().Add(-time.Second)}
+	if err := run.checkToken(); err == nil {
+		t.Fatal("expected error with an expired token")
+	}
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	"github.com/hesusruiz/isbetmf/reporting"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
+)
+
+// lifecycleHistoryProperty is the property where the server records the changes of the lifecycleStatus of an object
+const lifecycleHistoryProperty = "lifecycleStatusHistory"
+
+// LifecycleStateMachine defines the values of the lifecycleStatus of the objects of a resource,
+// and the transitions allowed between them.
+type LifecycleStateMachine struct {
+	// Initial are the values allowed when an object is created. If empty, any known value is allowed.
+	Initial []string `json:"initial,omitempty"`
+
+	// Transitions maps each value to the values which can follow it. Final values have an empty list.
+	Transitions map[string][]string `json:"transitions"`
+}
+
+// LifecyclePolicy maps the resource names to the state machines of their lifecycleStatus.
+// The objects of resources not included can have any value.
+type LifecyclePolicy map[string]*LifecycleStateMachine
+
+// tmf620Lifecycle is the lifecycle of the catalog entities described in TMF620:
+// In study → In design → Launched → Retired → Obsolete, with the optional test phase and rejection.
+var tmf620Lifecycle = &LifecycleStateMachine{
+	Transitions: map[string][]string{
+		"In study":  {"In design", "Rejected"},
+		"In design": {"In study", "In test", "Launched", "Rejected"},
+		"In test":   {"In design", "Launched", "Rejected"},
+		"Launched":  {"Retired"},
+		"Retired":   {"Launched", "Obsolete"},
+		"Rejected":  {},
+		"Obsolete":  {},
+	},
+}
+
+// DefaultLifecyclePolicy returns the TMF620 lifecycle for the catalog entities
+func DefaultLifecyclePolicy() LifecyclePolicy {
+	return LifecyclePolicy{
+		"catalog":              tmf620Lifecycle,
+		"category":             tmf620Lifecycle,
+		"productOffering":      tmf620Lifecycle,
+		"productOfferingPrice": tmf620Lifecycle,
+		"productSpecification": tmf620Lifecycle,
+	}
+}
+
+// LoadLifecyclePolicy returns the policy specified in the configuration: empty for none, "tmf620" for
+// DefaultLifecyclePolicy, or the path of a JSON file with an object like
+// {"productOffering": {"initial": ["In study"], "transitions": {"In study": ["In design"], "In design": []}}}
+func LoadLifecyclePolicy(spec string) (LifecyclePolicy, error) {
+	switch spec {
+	case "", "off":
+		return nil, nil
+	case "tmf620":
+		return DefaultLifecyclePolicy(), nil
+	}
+
+	content, err := os.ReadFile(spec)
+	if err != nil {
+		return nil, errl.Errorf("failed to read lifecycle policy: %w", err)
+	}
+	var policy LifecyclePolicy
+	if err := json.Unmarshal(content, &policy); err != nil {
+		return nil, errl.Errorf("invalid lifecycle policy %s: %w", spec, err)
+	}
+	for resourceName, machine := range policy {
+		if err := machine.validate(); err != nil {
+			return nil, errl.Errorf("invalid lifecycle of %s: %w", resourceName, err)
+		}
+	}
+	return policy, nil
+}
+
+// validate checks that all the values used in the state machine are defined as states
+func (m *LifecycleStateMachine) validate() error {
+	if m == nil || len(m.Transitions) == 0 {
+		return errl.Errorf("no transitions defined")
+	}
+	for _, status := range m.Initial {
+		if !m.HasState(status) {
+			return errl.Errorf("initial value %q is not a state", status)
+		}
+	}
+	for from, targets := range m.Transitions {
+		for _, to := range targets {
+			if !m.HasState(to) {
+				return errl.Errorf("transition from %q to %q, which is not a state", from, to)
+			}
+		}
+	}
+	return nil
+}
+
+// HasState reports whether status is one of the values of the state machine
+func (m *LifecycleStateMachine) HasState(status string) bool {
+	_, ok := m.Transitions[status]
+	return ok
+}
+
+// Next returns the values which can follow status. For a new object (an empty status) they are the initial values.
+func (m *LifecycleStateMachine) Next(status string) []string {
+	if status != "" {
+		return m.Transitions[status]
+	}
+	if len(m.Initial) > 0 {
+		return m.Initial
+	}
+	states := make([]string, 0, len(m.Transitions))
+	for state := range m.Transitions {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	return states
+}
+
+// Allowed reports whether the lifecycleStatus can change from one value to another.
+// Objects with a value unknown to the state machine, like the ones created before it was configured,
+// can move to any known value.
+func (m *LifecycleStateMachine) Allowed(from, to string) bool {
+	if from == to {
+		return true
+	}
+	if !m.HasState(to) {
+		return false
+	}
+	if from != "" && !m.HasState(from) {
+		return true
+	}
+	return slices.Contains(m.Next(from), to)
+}
+
+// SetLifecycle sets the state machines of the lifecycleStatus of the resources. A nil policy disables the checks.
+func (svc *Service) SetLifecycle(policy LifecyclePolicy) {
+	svc.lifecycle = policy
+}
+
+// lifecycleTransition is a change of the lifecycleStatus of an object, made available to the policies
+type lifecycleTransition struct {
+	from    string
+	to      string
+	allowed []string
+}
+
+func (t *lifecycleTransition) toMap() map[string]any {
+	if t == nil {
+		return map[string]any{}
+	}
+	allowed := make([]any, len(t.allowed))
+	for i, status := range t.allowed {
+		allowed[i] = status
+	}
+	return map[string]any{
+		"from":    t.from,
+		"to":      t.to,
+		"allowed": allowed,
+	}
+}
+
+// applyLifecycle checks the change of the lifecycleStatus of obj from the previous value (empty for a new object)
+// against the state machine of the resource, if any. Allowed changes are recorded in the object, with the
+// organization of the caller and the date, and in the request, so they are available to the policies.
+// It returns a response if the transition is not allowed, or nil otherwise.
+func (svc *Service) applyLifecycle(req *Request, previous string, obj map[string]any, date string) *Response {
+	machine := svc.lifecycle[req.ResourceName]
+	if machine == nil {
+		return nil
+	}
+
+	// The history is maintained by the server, overwriting whatever the caller sent
+	if previous == "" {
+		delete(obj, lifecycleHistoryProperty)
+	}
+
+	status, _ := obj["lifecycleStatus"].(string)
+	if status == previous || (status == "" && previous == "") {
+		return nil
+	}
+
+	if !machine.Allowed(previous, status) {
+		var err error
+		if status == "" {
+			err = errl.Errorf("lifecycleStatus of %s can not be removed", req.ResourceName)
+		} else if previous == "" {
+			err = errl.Errorf("lifecycleStatus %q is not allowed for a new %s, expected one of %s", status, req.ResourceName, quoteAll(machine.Next("")))
+		} else {
+			err = errl.Errorf("lifecycleStatus of %s can not change from %q to %q, expected one of %s", req.ResourceName, previous, status, quoteAll(machine.Next(previous)))
+		}
+		apiErr := NewApiError("400", "Bad Request", err.Error(), fmt.Sprintf("%d", http.StatusBadRequest), "")
+		apiErr.Details = []ErrorDetail{{Pointer: "/lifecycleStatus", Message: err.Error()}}
+		slog.Error("Invalid lifecycleStatus transition", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID),
+			slog.String("from", previous), slog.String("to", status))
+		return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+	}
+
+	// The object must have the fields required for its new status
+	if status != "" {
+		if resp := svc.checkStatusFields(req, obj, status); resp != nil {
+			return resp
+		}
+	}
+
+	changedBy := req.AuthUser.OrganizationIdentifier
+	if !strings.HasPrefix(changedBy, "did:elsi:") {
+		changedBy = "did:elsi:" + changedBy
+	}
+	entry := map[string]any{
+		"lifecycleStatus": status,
+		"changeDate":      date,
+		"changedBy":       changedBy,
+	}
+	if previous != "" {
+		entry["previousLifecycleStatus"] = previous
+	}
+	history, _ := obj[lifecycleHistoryProperty].([]any)
+	obj[lifecycleHistoryProperty] = append(history, entry)
+
+	req.lifecycle = &lifecycleTransition{from: previous, to: status, allowed: machine.Next(previous)}
+	return nil
+}
+
+// defaultStatusValidator provides the fields required by each lifecycleStatus when the server has no Validator
+var defaultStatusValidator = NewServerValidator()
+
+// statusValidator returns the Validator with the rules for the fields required by each lifecycleStatus
+func (svc *Service) statusValidator() *reporting.Validator {
+	if svc.validator != nil {
+		return svc.validator
+	}
+	return defaultStatusValidator
+}
+
+// checkStatusFields verifies that obj has the fields required for the new status by the rules of the
+// Validator, like a price and a category for a Launched offering. It returns a response listing all the
+// fields missing, or nil if there are none.
+func (svc *Service) checkStatusFields(req *Request, obj map[string]any, status string) *Response {
+	// An enforcing Validator checks the same fields on every write, in validateRules
+	if svc.validator != nil && svc.validatorMode == openapi.ModeEnforce && svc.validator.ChecksStatusFields() {
+		return nil
+	}
+
+	result := svc.statusValidator().ValidateForStatus(reporting.NewTMFObjectFromMap(obj), req.ResourceName, status)
+	if result.Valid {
+		return nil
+	}
+
+	details := make([]ErrorDetail, len(result.Errors))
+	fields := make([]string, len(result.Errors))
+	for i, e := range result.Errors {
+		details[i] = ErrorDetail{Pointer: fieldPointer(e.Field), Message: e.Message}
+		fields[i] = e.Field
+	}
+	err := errl.Errorf("%s can not have lifecycleStatus %q, missing %s", req.ResourceName, status, strings.Join(fields, ", "))
+	apiErr := NewApiError("400", "Bad Request", err.Error(), fmt.Sprintf("%d", http.StatusBadRequest), "")
+	apiErr.Details = details
+	slog.Error("Object incomplete for lifecycleStatus", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID),
+		slog.String("status", status), slog.Any("missing", fields))
+	return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+}
+
+// lifecycleReferences returns the references required by the new lifecycleStatus of obj, if the request
+// changes it, so the objects referenced are checked like the other references, with the integrity policy.
+func (svc *Service) lifecycleReferences(req *Request, obj map[string]any) []reference {
+	if req.lifecycle == nil || req.lifecycle.to == "" {
+		return nil
+	}
+	return statusReferences(obj, svc.statusValidator().FieldsForStatus(req.ResourceName, req.lifecycle.to))
+}
+
+// statusReferences returns the references in the required fields which are ids of other objects, like
+// "productSpecification.id", with the JSON pointer to each id. The resource referenced is taken from the
+// @referredType of the reference or, if it has none, from the name of the field.
+func statusReferences(obj map[string]any, fields []string) []reference {
+	var refs []reference
+	add := func(name, pointer string, value any) {
+		m, _ := value.(map[string]any)
+		ref, ok := asReference(m)
+		if !ok {
+			ref.id, _ = m["id"].(string)
+			ref.href, _ = m["href"].(string)
+			ref.resourceName = name
+		}
+		if ref.id != "" {
+			ref.pointer = pointer + "/id"
+			refs = append(refs, ref)
+		}
+	}
+
+	for _, field := range fields {
+		name, rest, _ := strings.Cut(field, ".")
+		if rest != "id" {
+			continue
+		}
+		switch v := obj[name].(type) {
+		case map[string]any:
+			add(name, "/"+escapePointer(name), v)
+		case []any:
+			for i, elem := range v {
+				add(name, fmt.Sprintf("/%s/%d", escapePointer(name), i), elem)
+			}
+		}
+	}
+	return refs
+}
+
+// fieldPointer converts the name of a field reported by the Validator, like "category[0].id", to a JSON pointer
+func fieldPointer(field string) string {
+	field = strings.ReplaceAll(field, "[", ".")
+	field = strings.ReplaceAll(field, "]", "")
+	parts := strings.Split(field, ".")
+	for i, p := range parts {
+		parts[i] = escapePointer(p)
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// quoteAll returns a list of values for error messages
+func quoteAll(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
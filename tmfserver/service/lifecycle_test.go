@@ -0,0 +1,170 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+)
+
+func TestLoadLifecyclePolicy(t *testing.T) {
+	policy, err := LoadLifecyclePolicy("tmf620")
+	if err != nil {
+		t.Fatal(err)
+	}
+	machine := policy["productOffering"]
+	for _, tc := range []struct {
+		from, to string
+		allowed  bool
+	}{
+		{"", "Launched", true},
+		{"In study", "In design", true},
+		{"In design", "Launched", true},
+		{"Launched", "Retired", true},
+		{"Retired", "Obsolete", true},
+		{"Launched", "In study", false},
+		{"Obsolete", "Launched", false},
+		{"Active", "Retired", true},
+		{"Launched", "Active", false},
+	} {
+		if machine.Allowed(tc.from, tc.to) != tc.allowed {
+			t.Errorf("%q -> %q: expected allowed=%v", tc.from, tc.to, tc.allowed)
+		}
+	}
+
+	file := filepath.Join(t.TempDir(), "lifecycle.json")
+	os.WriteFile(file, []byte(`{"category": {"transitions": {"Active": ["Retired"]}}}`), 0o644)
+	if _, err := LoadLifecyclePolicy(file); err == nil {
+		t.Fatalf("expected error for transition to an undefined state")
+	}
+}
+
+func TestLifecycleTransitions(t *testing.T) {
+	s := newTestService(t)
+	s.SetLifecycle(DefaultLifecyclePolicy())
+
+	fdel := &fakeDelivery{}
+	s.notif = notifications.NewManager(notifications.NewMemoryStore(), fdel)
+	sub := &notifications.Subscription{
+		ID:         "sub1",
+		APIFamily:  "productCatalogManagement",
+		Callback:   "http://localhost:9991/listener",
+		EventTypes: []string{"ProductOfferingStateChangeEvent", "ProductOfferingAttributeValueChangeEvent"},
+	}
+	if _, err := s.notif.CreateSubscription("productCatalogManagement", sub); err != nil {
+		t.Fatalf("create sub: %v", err)
+	}
+
+	id := "urn:ngsi-ld:product-offering:1"
+	b, _ := json.Marshal(map[string]any{"id": id, "name": "Cloud storage", "lifecycleStatus": "Draft"})
+	if resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "productOffering", "", b, nil)); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("create: expected 400 for unknown status, got %d", resp.StatusCode)
+	}
+	b, _ = json.Marshal(map[string]any{"id": id, "name": "Cloud storage", "lifecycleStatus": "In design"})
+	if resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "productOffering", "", b, nil)); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create: expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+
+	update := func(patch map[string]any) *Response {
+		b, _ := json.Marshal(patch)
+		return s.UpdateGenericObject(newReq("PATCH", "UPDATE", "productCatalogManagement", "productOffering", id, b, nil))
+	}
+
+	// A Launched offering must be complete, and all the missing fields are reported
+	resp := update(map[string]any{"version": "1.1", "lifecycleStatus": "Launched", "category": []any{map[string]any{"name": "Storage"}}})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("launch: expected 400 for incomplete offering, got %d", resp.StatusCode)
+	}
+	var pointers []string
+	for _, d := range resp.Body.(*ApiError).Details {
+		pointers = append(pointers, d.Pointer)
+	}
+	if !reflect.DeepEqual(pointers, []string{"/productOfferingPrice", "/productSpecification", "/category/0/id", "/validFor"}) {
+		t.Fatalf("launch: unexpected details %v", pointers)
+	}
+
+	// The price and category must exist, while the specification is only warned about by the integrity policy
+	policy, _ := ParseIntegrityPolicy("strict,productSpecification=warn")
+	s.SetIntegrity(policy)
+	launch := map[string]any{
+		"version":              "1.1",
+		"lifecycleStatus":      "Launched",
+		"productOfferingPrice": []any{map[string]any{"id": "urn:ngsi-ld:product-offering-price:1"}},
+		"productSpecification": map[string]any{"id": "urn:ngsi-ld:product-specification:1"},
+		"category":             []any{map[string]any{"id": "urn:ngsi-ld:category:1"}},
+		"validFor":             map[string]any{"startDateTime": "2025-01-01T00:00:00Z"},
+	}
+	resp = update(launch)
+	if resp.StatusCode != http.StatusBadRequest || len(resp.Body.(*ApiError).Details) != 2 {
+		t.Fatalf("launch: expected 400 for missing references, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	createReferenced(t, s, "productOfferingPrice", "urn:ngsi-ld:product-offering-price:1")
+	createReferenced(t, s, "category", "urn:ngsi-ld:category:1")
+
+	resp = update(map[string]any{
+		"version":              "1.1",
+		"lifecycleStatus":      "Launched",
+		"productOfferingPrice": []any{map[string]any{"id": "urn:ngsi-ld:product-offering-price:1"}},
+		"productSpecification": map[string]any{"id": "urn:ngsi-ld:product-specification:1"},
+		"category":             []any{map[string]any{"id": "urn:ngsi-ld:category:1"}},
+		"validFor":             map[string]any{"startDateTime": "2025-01-01T00:00:00Z"},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("launch: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+
+	resp = update(map[string]any{"version": "1.2", "lifecycleStatus": "In study"})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("update: expected 400 for illegal transition, got %d", resp.StatusCode)
+	}
+	if details := resp.Body.(*ApiError).Details; len(details) != 1 || details[0].Pointer != "/lifecycleStatus" {
+		t.Fatalf("update: unexpected details %+v", details)
+	}
+
+	// The history can not be set by the caller
+	resp = update(map[string]any{"version": "1.2", "lifecycleStatus": "Retired", lifecycleHistoryProperty: []any{}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("update: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	history, _ := resp.Body.(map[string]any)[lifecycleHistoryProperty].([]any)
+	if len(history) != 3 {
+		t.Fatalf("update: expected three status changes, got %v", history)
+	}
+	last := history[2].(map[string]any)
+	if last["lifecycleStatus"] != "Retired" || last["previousLifecycleStatus"] != "Launched" || last["changedBy"] == "" {
+		t.Fatalf("update: unexpected status change %v", last)
+	}
+
+	if resp := update(map[string]any{"version": "1.3", "description": "Discontinued"}); resp.StatusCode != http.StatusOK {
+		t.Fatalf("update: expected 200, got %d", resp.StatusCode)
+	}
+
+	// Wait briefly for goroutine delivery
+	time.Sleep(200 * time.Millisecond)
+
+	if len(fdel.deliveries) != 3 {
+		t.Fatalf("expected 3 deliveries, got %d", len(fdel.deliveries))
+	}
+	// Deliveries run concurrently, so they can arrive in any order
+	received := map[any]bool{}
+	for _, d := range fdel.deliveries {
+		received[d.(map[string]any)["eventType"]] = true
+	}
+	if !received["ProductOfferingStateChangeEvent"] || !received["ProductOfferingAttributeValueChangeEvent"] {
+		t.Fatalf("unexpected events: %v", received)
+	}
+}
+
+// createReferenced creates an object with the given id, to be referenced by other objects
+func createReferenced(t *testing.T, s *Service, resourceName, id string) {
+	t.Helper()
+	b, _ := json.Marshal(map[string]any{"id": id, "name": resourceName})
+	if resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil)); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create %s: expected 201, got %d: %+v", resourceName, resp.StatusCode, resp.Body)
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/config"
+	"github.com/hesusruiz/isbetmf/internal/errl"
+)
+
+// resourceFamily returns the API family where a resource is defined. The live OpenAPI specs are used
+// if they were loaded, and the mapping generated from the specs at build time otherwise.
+func (svc *Service) resourceFamily(resourceName string) (string, bool) {
+	if svc.specs != nil {
+		family, ok := svc.specs.FamilyOf(resourceName)
+		return family, ok
+	}
+	family, ok := config.GeneratedISBEResourceToManagement[resourceName]
+	return family, ok
+}
+
+// knownFamily returns true if the server implements the API family
+func (svc *Service) knownFamily(apiFamily string) bool {
+	if svc.specs != nil {
+		_, ok := svc.specs.Spec(apiFamily)
+		return ok
+	}
+	for _, family := range config.GeneratedISBEResourceToManagement {
+		if family == apiFamily {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckRoute verifies that the server implements the API family and that the resource belongs to it,
+// so a typo in the URL does not create a new type of object.
+// If resourceName is empty, only the API family is checked. It returns a 404 response for unknown
+// API families and resources, and nil otherwise.
+func (svc *Service) CheckRoute(apiFamily, resourceName string) *Response {
+	if !svc.knownFamily(apiFamily) {
+		return notFoundResponse(errl.Errorf("unknown API family: %s", apiFamily))
+	}
+	if resourceName == "" {
+		return nil
+	}
+
+	family, ok := svc.resourceFamily(resourceName)
+	if !ok {
+		return notFoundResponse(errl.Errorf("unknown resource %s in API family %s", resourceName, apiFamily))
+	}
+	if family != apiFamily {
+		return notFoundResponse(errl.Errorf("resource %s does not belong to API family %s, but to %s", resourceName, apiFamily, family))
+	}
+	return nil
+}
+
+// resourceOfType returns the name of the resource of an object from its @type, like "productOffering" for
+// "ProductOffering", or an empty string if the object has no @type.
+func resourceOfType(obj map[string]any) string {
+	typeVal, _ := obj["@type"].(string)
+	if typeVal == "" {
+		return ""
+	}
+	return strings.ToLower(typeVal[:1]) + typeVal[1:]
+}
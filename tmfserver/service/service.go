@@ -1,6 +1,7 @@
 package service
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"log/slog"
@@ -18,7 +20,9 @@ import (
 	"github.com/hesusruiz/isbetmf/config"
 	"github.com/hesusruiz/isbetmf/internal/errl"
 	pdp "github.com/hesusruiz/isbetmf/pdp"
+	"github.com/hesusruiz/isbetmf/reporting"
 	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
 	"github.com/hesusruiz/isbetmf/tmfserver/repository"
 	repo "github.com/hesusruiz/isbetmf/tmfserver/repository"
 	"github.com/jmoiron/sqlx"
@@ -60,19 +64,24 @@ type Request struct {
 	APIfamily    string
 	ResourceName string
 	ID           string
+	TopicID      string // Only for TMF688 Event Management requests
 	QueryParams  url.Values
 	Body         []byte
 	AuthUser     *AuthUser
 	AccessToken  string
+
+	// The change of the lifecycleStatus of the object made by the request, if any
+	lifecycle *lifecycleTransition
 }
 
 func (r *Request) ToMap() map[string]any {
 	return map[string]any{
-		"method":   r.Method,
-		"action":   r.Action,
-		"api":      r.APIfamily,
-		"resource": r.ResourceName,
-		"id":       r.ID,
+		"method":    r.Method,
+		"action":    r.Action,
+		"api":       r.APIfamily,
+		"resource":  r.ResourceName,
+		"id":        r.ID,
+		"lifecycle": r.lifecycle.toMap(),
 	}
 }
 
@@ -93,7 +102,7 @@ type Response struct {
 
 // Service is the service for the API.
 type Service struct {
-	db         *sqlx.DB
+	db         database
 	ruleEngine *pdp.PDP
 	// The public key used to verify the Access Tokens. In DOME they belong to the Verifier,
 	// and the PDP retrieves it dynamically depending on the environment.
@@ -108,8 +117,35 @@ type Service struct {
 	// Notifications manager
 	notif *notifications.Manager
 
+	// Rules applied to the callback URLs of hub subscriptions
+	callbackPolicy *notifications.CallbackPolicy
+
+	// Bearer token required to read the delivery metrics of the subscriptions. Without it they are not served.
+	metricsToken string
+
 	// Pluggable storage backend (optional). When nil, falls back to built-in SQLite via db
 	storage Storage
+
+	// OpenAPI specs of the API families, used to validate the objects received (optional)
+	specs      *openapi.Registry
+	schemaMode openapi.Mode
+
+	// Validator of the reporting tool applied to the objects written (optional)
+	validator     *reporting.Validator
+	validatorMode openapi.Mode
+
+	// Checks of the references between objects (optional)
+	integrity *IntegrityPolicy
+
+	// State machines of the lifecycleStatus of the resources (optional)
+	lifecycle LifecyclePolicy
+
+	// Directory where the import and export jobs write their files, and the jobs running in the background
+	jobsDir string
+	jobs    *sync.WaitGroup
+
+	// Events of the operations of a bulk request running in a transaction, published when it is committed
+	pendingEvents *[]pendingEvent
 }
 
 // NewService creates a new service.
@@ -118,6 +154,7 @@ func NewService(db *sqlx.DB, ruleEngine *pdp.PDP, verifierServer string) *Servic
 		db:             db,
 		ruleEngine:     ruleEngine,
 		verifierServer: verifierServer,
+		jobs:           &sync.WaitGroup{},
 	}
 
 	err := svc.initializeService()
@@ -125,14 +162,50 @@ func NewService(db *sqlx.DB, ruleEngine *pdp.PDP, verifierServer string) *Servic
 		panic(err)
 	}
 
-	// Initialize notifications with in-memory store and HTTP delivery
+	// Initialize notifications with in-memory store and HTTP delivery.
+	// Callbacks are restricted by default to public addresses, to avoid SSRF attacks.
+	svc.callbackPolicy = notifications.DefaultCallbackPolicy()
 	store := notifications.NewMemoryStore()
-	deliver := notifications.NewHTTPDelivery(5 * time.Second)
+	deliver := notifications.NewHTTPDeliveryWithPolicy(5*time.Second, svc.callbackPolicy)
 	svc.notif = notifications.NewManager(store, deliver)
+	svc.notif.SetCallbackPolicy(svc.callbackPolicy)
+
+	// Each API family implemented by the server is a TMF688 topic
+	svc.registerDefaultTopics()
 
 	return svc
 }
 
+// CallbackPolicy returns the policy applied to the callbacks of hub subscriptions,
+// so the caller can customize it before starting to serve requests.
+func (svc *Service) CallbackPolicy() *notifications.CallbackPolicy {
+	return svc.callbackPolicy
+}
+
+// Notifications returns the manager of hub subscriptions and events, so the caller can
+// register additional sinks before starting to serve requests.
+func (svc *Service) Notifications() *notifications.Manager {
+	return svc.notif
+}
+
+// SetMetricsToken sets the bearer token which the scrapers of the delivery metrics must send.
+// An empty token disables the metrics, which are not served by default.
+func (svc *Service) SetMetricsToken(token string) {
+	svc.metricsToken = token
+}
+
+// CheckMetricsAccess returns a response if the request can not read the delivery metrics, or nil if it can.
+// The metrics of all the subscriptions are only available with the token configured for the scrapers.
+func (svc *Service) CheckMetricsAccess(req *Request) *Response {
+	if svc.metricsToken == "" {
+		return notFoundResponse(errl.Errorf("metrics are not enabled"))
+	}
+	if subtle.ConstantTimeCompare([]byte(req.AccessToken), []byte(svc.metricsToken)) != 1 {
+		return unauthorizedResponse(errl.Errorf("invalid metrics token"))
+	}
+	return nil
+}
+
 func (svc *Service) initializeService() error {
 
 	// Create the server operator identity, in case it is not yet in the database
@@ -169,13 +242,17 @@ func (svc *Service) initializeService() error {
 // CreateHubSubscription creates a new notification subscription (hub) for an API family.
 func (svc *Service) CreateHubSubscription(req *Request) *Response {
 	// Authenticate like write operations
-	_, err := svc.extractCallerInfo(req)
+	token, err := svc.extractCallerInfo(req)
 	if err != nil {
 		err = errl.Errorf("invalid access token: %w", err)
 		apiErr := NewApiError("401", "Unauthorized", err.Error(), fmt.Sprintf("%d", http.StatusUnauthorized), "")
 		slog.Error("Unauthorized request", slog.Any("error", err))
 		return &Response{StatusCode: http.StatusUnauthorized, Body: apiErr}
 	}
+	// The subscription belongs to the organization of the caller
+	if len(token) == 0 {
+		return unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
 
 	// Parse incoming body
 	var body map[string]any
@@ -211,6 +288,10 @@ func (svc *Service) CreateHubSubscription(req *Request) *Response {
 	}
 
 	query, _ := body["query"].(string)
+	if err := notifications.ValidateQuery(query); err != nil {
+		apiErr := NewApiError("400", "Bad Request", err.Error(), fmt.Sprintf("%d", http.StatusBadRequest), "")
+		return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+	}
 
 	// Build subscription
 	id := uuid.NewString()
@@ -221,10 +302,16 @@ func (svc *Service) CreateHubSubscription(req *Request) *Response {
 		EventTypes: eventTypes,
 		Headers:    headers,
 		Query:      query,
+		Owner:      req.AuthUser.OrganizationIdentifier,
 	}
 
 	_, err = svc.notif.CreateSubscription(req.APIfamily, sub)
 	if err != nil {
+		if errors.Is(err, &notifications.ErrInvalidCallback{}) {
+			apiErr := NewApiError("400", "Bad Request", err.Error(), fmt.Sprintf("%d", http.StatusBadRequest), "")
+			slog.Warn("Rejected hub subscription callback", slog.String("callback", callback), slog.Any("error", err))
+			return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+		}
 		err = errl.Errorf("failed to create subscription: %w", err)
 		apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
 		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
@@ -270,6 +357,112 @@ func (svc *Service) DeleteHubSubscription(req *Request) *Response {
 	return &Response{StatusCode: http.StatusNoContent}
 }
 
+// GetHubSubscription retrieves a subscription by id for an API family, including the results
+// of the deliveries of events to its callback. Only the organization which created it can retrieve it.
+func (svc *Service) GetHubSubscription(req *Request) *Response {
+	sub, resp := svc.ownedHubSubscription(req)
+	if resp != nil {
+		return resp
+	}
+	return svc.hubSubscriptionResponse(req, sub)
+}
+
+// UpdateHubSubscription changes the status of a subscription, which is the only property which can be updated.
+// Setting it to "active" resumes a subscription suspended after too many failed deliveries, once its
+// callback is working again.
+func (svc *Service) UpdateHubSubscription(req *Request) *Response {
+	sub, resp := svc.ownedHubSubscription(req)
+	if resp != nil {
+		return resp
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		return badRequestResponse(errl.Errorf("failed to bind request body: %w", err))
+	}
+	for key := range body {
+		if key != "status" {
+			return badRequestResponse(errl.Errorf("%s can not be updated, only the status", key))
+		}
+	}
+	if status, _ := body["status"].(string); status != notifications.StatusActive {
+		return badRequestResponse(errl.Errorf("invalid status %v, only %q is supported to resume the subscription", body["status"], notifications.StatusActive))
+	}
+
+	if err := svc.notif.ResumeSubscription(req.APIfamily, sub.ID); err != nil {
+		return notFoundResponse(errl.Errorf("hub %s: %w", req.ID, err))
+	}
+	slog.Info("Hub subscription resumed", slog.String("apiFamily", req.APIfamily), slog.String("id", sub.ID))
+
+	return svc.hubSubscriptionResponse(req, sub)
+}
+
+// ownedHubSubscription authenticates the request and returns the subscription in it, if the caller created it
+func (svc *Service) ownedHubSubscription(req *Request) (*notifications.Subscription, *Response) {
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return nil, unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return nil, unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	sub, err := svc.notif.GetSubscription(req.APIfamily, req.ID)
+	if err != nil {
+		return nil, notFoundResponse(errl.Errorf("hub %s: %w", req.ID, err))
+	}
+	if sub.Owner == "" || sub.Owner != req.AuthUser.OrganizationIdentifier {
+		err = errl.Errorf("hub %s was not created by the caller", req.ID)
+		apiErr := NewApiError("403", "Forbidden", err.Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		slog.Error("Unauthorized request", slog.Any("error", err))
+		return nil, &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+	return sub, nil
+}
+
+// hubSubscriptionResponse returns the subscription with the results of the deliveries to its callback
+func (svc *Service) hubSubscriptionResponse(req *Request, sub *notifications.Subscription) *Response {
+	stats, err := svc.notif.SubscriptionStats(req.APIfamily, sub.ID)
+	if err != nil {
+		return notFoundResponse(errl.Errorf("hub %s: %w", sub.ID, err))
+	}
+
+	resp := map[string]any{
+		"id":            sub.ID,
+		"callback":      sub.Callback,
+		"eventTypes":    sub.EventTypes,
+		"query":         sub.Query,
+		"href":          fmt.Sprintf("/tmf-api/%s/v5/hub/%s", req.APIfamily, sub.ID),
+		"status":        stats.Status,
+		"deliveryStats": deliveryStatsToTMF(stats),
+	}
+
+	return &Response{StatusCode: http.StatusOK, Body: resp}
+}
+
+// deliveryStatsToTMF converts the delivery results of a subscription to their JSON representation.
+// Times which did not happen yet are omitted, and latencies are in milliseconds.
+func deliveryStatsToTMF(st notifications.DeliveryStats) map[string]any {
+	result := map[string]any{
+		"delivered":           st.Delivered,
+		"failed":              st.Failed,
+		"consecutiveFailures": st.ConsecutiveFailures,
+		"lastLatencyMs":       st.LastLatency.Milliseconds(),
+		"avgLatencyMs":        st.AvgLatency().Milliseconds(),
+	}
+	if !st.LastSuccess.IsZero() {
+		result["lastSuccess"] = st.LastSuccess.Format(time.RFC3339Nano)
+	}
+	if !st.LastFailure.IsZero() {
+		result["lastFailure"] = st.LastFailure.Format(time.RFC3339Nano)
+		result["lastError"] = st.LastError
+	}
+	if !st.SuspendedAt.IsZero() {
+		result["suspendedAt"] = st.SuspendedAt.Format(time.RFC3339Nano)
+	}
+	return result
+}
+
 // CreateGenericObject creates a new TMF object using generalized parameters.
 func (svc *Service) CreateGenericObject(req *Request) *Response {
 	slog.Debug("CreateGenericObject called", slog.String("apiFamily", req.APIfamily), slog.String("resourceName", req.ResourceName))
@@ -291,6 +484,12 @@ func (svc *Service) CreateGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusUnauthorized, Body: apiErr}
 	}
 
+	// The ids of jobs name their files, and their status is maintained by the job, so they are only
+	// created and changed by the job operations
+	if IsJobResource(req.ResourceName) {
+		return badRequestResponse(errl.Errorf("%s can only be created with its own operation", req.ResourceName))
+	}
+
 	// Parse the request body, which contains the TMForum object being created
 	var incomingObjectMap map[string]any
 	if err := json.Unmarshal(req.Body, &incomingObjectMap); err != nil {
@@ -355,6 +554,22 @@ func (svc *Service) CreateGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
 	}
 
+	// Check the object against the schema of the resource in the OpenAPI spec
+	if resp := svc.validateBody(req, incomingObjectMap, false); resp != nil {
+		return resp
+	}
+
+	// Check the initial lifecycleStatus
+	if resp := svc.applyLifecycle(req, "", incomingObjectMap, lastUpdate); resp != nil {
+		return resp
+	}
+
+	// Check the object with the rules of the Validator
+	headers := make(map[string]string)
+	if resp := svc.validateRules(req, incomingObjectMap, headers); resp != nil {
+		return resp
+	}
+
 	incomingContent, err := json.Marshal(incomingObjectMap)
 	if err != nil {
 		err = errl.Errorf("failed to marshal object content: %w", err)
@@ -382,6 +597,11 @@ func (svc *Service) CreateGenericObject(req *Request) *Response {
 	// Now we can proceed, creating an object in the database.
 	// ************************************************************************************************
 
+	// The objects referenced are looked up only for authorized callers, so they can not probe which ones exist
+	if resp := svc.checkReferences(req, incomingObjectMap, svc.lifecycleReferences(req, incomingObjectMap)...); resp != nil {
+		return resp
+	}
+
 	if err := svc.createObject(obj); err != nil {
 		err = errl.Errorf("failed to create object in service: %w", err)
 		apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
@@ -389,14 +609,13 @@ func (svc *Service) CreateGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
 	}
 
-	headers := make(map[string]string)
 	headers["Location"] = incomingObjectMap["href"].(string)
 	slog.Info("Object created successfully", slog.String("id", id), slog.String("resourceName", req.ResourceName), slog.String("location", incomingObjectMap["href"].(string)))
 
 	// Send TMForum notification
 	eventType := toEventType(req.ResourceName, "CreateEvent")
 	eventPayload := buildEventPayload(req, eventType, incomingObjectMap)
-	svc.notif.PublishEvent(req.APIfamily, eventType, eventPayload)
+	svc.publishEvent(req.APIfamily, eventType, eventPayload)
 
 	return &Response{
 		StatusCode: http.StatusCreated,
@@ -458,6 +677,11 @@ func (svc *Service) GetGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
 	}
 
+	// Embed the objects referenced, if requested
+	if resp := svc.expandObjects(req, token, responseData); resp != nil {
+		return resp
+	}
+
 	// Handle partial field selection
 	fieldsParam := req.QueryParams.Get("fields")
 	if fieldsParam != "" {
@@ -515,6 +739,11 @@ func (svc *Service) UpdateGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusUnauthorized, Body: apiErr}
 	}
 
+	// Jobs are updated only by the job while it runs
+	if IsJobResource(req.ResourceName) {
+		return badRequestResponse(errl.Errorf("%s can not be updated", req.ResourceName))
+	}
+
 	// Parse the request body, which contains the TMForum object being created
 	var incomingObjMap map[string]any
 	if err := json.Unmarshal(req.Body, &incomingObjMap); err != nil {
@@ -563,6 +792,11 @@ func (svc *Service) UpdateGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
 	}
 
+	// Check the patch against the schema of the resource in the OpenAPI spec
+	if resp := svc.validateBody(req, incomingObjMap, true); resp != nil {
+		return resp
+	}
+
 	// Retrieve existing object from database to preserve CreatedAt
 	existingObj, err := svc.getObject(req.ID, req.ResourceName)
 	if err != nil {
@@ -638,9 +872,27 @@ func (svc *Service) UpdateGenericObject(req *Request) *Response {
 		}
 	}
 
+	// The history of the lifecycleStatus is maintained by the server
+	previousStatus, _ := existingMap["lifecycleStatus"].(string)
+	if svc.lifecycle[req.ResourceName] != nil {
+		delete(incomingObjMap, lifecycleHistoryProperty)
+	}
+
 	mergeRFC7396(existingMap, incomingObjMap)
 
+	// Check the transition of the lifecycleStatus, if it changes
+	if resp := svc.applyLifecycle(req, previousStatus, existingMap, lastUpdate); resp != nil {
+		return resp
+	}
+
+	// Check the resulting object with the rules of the Validator
+	headers := make(map[string]string)
+	if resp := svc.validateRules(req, existingMap, headers); resp != nil {
+		return resp
+	}
+
 	// update incomingObjMap to the merged result so response/notification contains the final content
+	patch := incomingObjMap
 	incomingObjMap = existingMap
 
 	incomingContent, err := json.Marshal(incomingObjMap)
@@ -661,6 +913,21 @@ func (svc *Service) UpdateGenericObject(req *Request) *Response {
 		UpdatedAt:  time.Now(),
 	}
 
+	// A transition of the lifecycleStatus is submitted to the PDP, so the policies can restrict who performs it
+	if req.lifecycle != nil {
+		if err := takeDecision(svc.ruleEngine, req, token, obj); err != nil {
+			err = errl.Errorf("user not authorized to change lifecycleStatus: %w", err)
+			apiErr := NewApiError("403", "Forbidden", err.Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+			slog.Error("Unauthorized request", slog.Any("error", err))
+			return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+		}
+	}
+
+	// The objects referenced in the patch are looked up only after the authorization checks
+	if resp := svc.checkReferences(req, patch, svc.lifecycleReferences(req, incomingObjMap)...); resp != nil {
+		return resp
+	}
+
 	if err := svc.updateObject(obj); err != nil {
 		err = errl.Errorf("failed to update object in service: %w", err)
 		apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
@@ -670,12 +937,15 @@ func (svc *Service) UpdateGenericObject(req *Request) *Response {
 
 	slog.Info("Object updated successfully", slog.String("id", req.ID), slog.String("resourceName", req.ResourceName))
 
-	// Send TMForum notification (AttributeValueChangeEvent)
+	// Send TMForum notification: StateChangeEvent if the lifecycleStatus changed, AttributeValueChangeEvent otherwise
 	eventType := toEventType(req.ResourceName, "AttributeValueChangeEvent")
+	if status, _ := incomingObjMap["lifecycleStatus"].(string); status != previousStatus {
+		eventType = toEventType(req.ResourceName, "StateChangeEvent")
+	}
 	eventPayload := buildEventPayload(req, eventType, incomingObjMap)
-	svc.notif.PublishEvent(req.APIfamily, eventType, eventPayload)
+	svc.publishEvent(req.APIfamily, eventType, eventPayload)
 
-	return &Response{StatusCode: http.StatusOK, Body: incomingObjMap}
+	return &Response{StatusCode: http.StatusOK, Headers: headers, Body: incomingObjMap}
 }
 
 // DeleteGenericObject deletes a TMF object using generalized parameters.
@@ -699,6 +969,38 @@ func (svc *Service) DeleteGenericObject(req *Request) *Response {
 		return &Response{StatusCode: http.StatusUnauthorized, Body: apiErr}
 	}
 
+	existingObj, err := svc.getObject(req.ID, req.ResourceName)
+	if err != nil {
+		err = errl.Errorf("failed to get object from service: %w", err)
+		apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
+		slog.Error("Failed to get object from service", slog.Any("error", err), slog.String("id", req.ID), slog.String("resourceName", req.ResourceName))
+		return &Response{StatusCode: http.StatusInternalServerError, Body: apiErr}
+	}
+	if existingObj == nil {
+		err = errl.Errorf("object not found")
+		apiErr := NewApiError("404", "Not Found", err.Error(), fmt.Sprintf("%d", http.StatusNotFound), "")
+		slog.Info("Object not found", slog.String("id", req.ID), slog.String("resourceName", req.ResourceName))
+		return &Response{StatusCode: http.StatusNotFound, Body: apiErr}
+	}
+
+	// ************************************************************************************************
+	// Before performing the action, check if the user can perform the operation on the object.
+	// ************************************************************************************************
+
+	err = takeDecision(svc.ruleEngine, req, token, existingObj)
+	if err != nil {
+		err = errl.Errorf("user not authorized: %w", err)
+		apiErr := NewApiError("403", "Forbidden", err.Error(), fmt.Sprintf("%d", http.StatusForbidden), "")
+		slog.Error("Unauthorized request", slog.Any("error", err))
+		return &Response{StatusCode: http.StatusForbidden, Body: apiErr}
+	}
+
+	// Objects can not be deleted while other objects reference them, depending on the integrity policy.
+	// They are looked up only for authorized callers, so they can not probe which objects reference others.
+	if resp := svc.checkReferencedBy(req, token); resp != nil {
+		return resp
+	}
+
 	if err := svc.deleteObject(req.ID, req.ResourceName); err != nil {
 		err = errl.Errorf("failed to delete object from service: %w", err)
 		apiErr := NewApiError("500", "Internal Server Error", err.Error(), fmt.Sprintf("%d", http.StatusInternalServerError), "")
@@ -716,7 +1018,7 @@ func (svc *Service) DeleteGenericObject(req *Request) *Response {
 		"href":  fmt.Sprintf("/tmf-api/%s/v5/%s/%s", req.APIfamily, req.ResourceName, req.ID),
 	}
 	eventPayload := buildEventPayload(req, eventType, minimal)
-	svc.notif.PublishEvent(req.APIfamily, eventType, eventPayload)
+	svc.publishEvent(req.APIfamily, eventType, eventPayload)
 
 	return &Response{StatusCode: http.StatusNoContent}
 }
@@ -726,7 +1028,7 @@ func (svc *Service) ListGenericObjects(req *Request) *Response {
 	slog.Debug("ListGenericObjects called", slog.String("resourceName", req.ResourceName))
 
 	// Authentication: process the AccessToken to extract caller info from its claims in the payload
-	_, err := svc.extractCallerInfo(req)
+	token, err := svc.extractCallerInfo(req)
 	if err != nil {
 		err = errl.Errorf("invalid access token: %w", err)
 		apiErr := NewApiError("401", "Unauthorized", err.Error(), fmt.Sprintf("%d", http.StatusUnauthorized), "")
@@ -758,6 +1060,11 @@ func (svc *Service) ListGenericObjects(req *Request) *Response {
 		responseData = append(responseData, item)
 	}
 
+	// Embed the objects referenced, if requested
+	if resp := svc.expandObjects(req, token, responseData...); resp != nil {
+		return resp
+	}
+
 	// Handle partial field selection
 	fieldsParam := req.QueryParams.Get("fields")
 	if fieldsParam != "" {
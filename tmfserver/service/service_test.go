@@ -5,10 +5,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/hesusruiz/isbetmf/internal/jpath"
+	"github.com/hesusruiz/isbetmf/reporting"
 	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
 	"github.com/hesusruiz/isbetmf/tmfserver/repository"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
@@ -30,7 +35,7 @@ func newTestService(t *testing.T) *Service {
 	}
 
 	// Create service struct directly (no external verifier)
-	s := &Service{db: db}
+	s := &Service{db: db, jobs: &sync.WaitGroup{}}
 	// Wire notifications manager to a fake delivery by default
 	s.notif = notifications.NewManager(notifications.NewMemoryStore(), &fakeDelivery{})
 	return s
@@ -79,12 +84,103 @@ func TestCreateAndDeleteHubSubscription(t *testing.T) {
 		t.Fatalf("expected id in response")
 	}
 
+	// Get, with the delivery status
+	getResp := s.GetHubSubscription(newReq("GET", "READ", "TMF620", "", id, nil, nil))
+	if getResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", getResp.StatusCode)
+	}
+	if hub := getResp.Body.(map[string]any); hub["status"] != "active" || hub["deliveryStats"] == nil {
+		t.Fatalf("unexpected hub: %v", hub)
+	}
+
 	// Delete
 	delReq := newReq("DELETE", "DELETE", "TMF620", "", id, nil, nil)
 	delResp := s.DeleteHubSubscription(delReq)
 	if delResp.StatusCode != http.StatusNoContent {
 		t.Fatalf("expected 204, got %d", delResp.StatusCode)
 	}
+
+	if getResp = s.GetHubSubscription(newReq("GET", "READ", "TMF620", "", id, nil, nil)); getResp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", getResp.StatusCode)
+	}
+}
+
+func TestValidationWarningsHeaderIsEscaped(t *testing.T) {
+	s := newTestService(t)
+	rules, err := reporting.ParseRules([]byte("rules:\n  - name: name-format\n    path: name\n    pattern: '^[A-Za-z ]+$'\n    severity: warning\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetValidator(reporting.NewValidatorWithRules(&reporting.Config{Version: reporting.VersionV5}, rules), openapi.ModeWarn)
+
+	// The values of the object quoted in the warnings can not break the header
+	b, _ := json.Marshal(map[string]any{"name": "Offering\r\nX-Injected: 1 \u00e9"})
+	resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "productOffering", "", b, nil))
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	warnings := resp.Headers[ValidationWarningsHeader]
+	if !strings.Contains(warnings, `Offering\r\nX-Injected: 1 \u00e9`) {
+		t.Fatalf("unexpected warnings: %q", warnings)
+	}
+	for _, r := range warnings {
+		if r < 0x20 || r > 0x7e {
+			t.Fatalf("unexpected character %q in warnings: %q", r, warnings)
+		}
+	}
+}
+
+func TestHubSubscriptionOnlyForItsOwner(t *testing.T) {
+	s := newTestService(t)
+
+	b, _ := json.Marshal(map[string]any{"callback": "http://localhost:9991/listener/test"})
+	resp := s.CreateHubSubscription(newReq("POST", "CREATE", "TMF620", "", "", b, nil))
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	id, _ := resp.Body.(map[string]any)["id"].(string)
+
+	update := func(body map[string]any) *Response {
+		b, _ := json.Marshal(body)
+		return s.UpdateHubSubscription(newReq("PATCH", "UPDATE", "TMF620", "", id, b, nil))
+	}
+
+	// The status is the only property which can be updated, to resume the subscription
+	if resp := update(map[string]any{"status": "active"}); resp.StatusCode != http.StatusOK || resp.Body.(map[string]any)["status"] != "active" {
+		t.Fatalf("resume: expected 200, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	if resp := update(map[string]any{"status": "suspended"}); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("suspend: expected 400, got %d", resp.StatusCode)
+	}
+	if resp := update(map[string]any{"status": "active", "callback": "http://localhost:9991/listener/other"}); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("update callback: expected 400, got %d", resp.StatusCode)
+	}
+
+	// Other organizations can not see or resume it
+	sub, _ := s.notif.GetSubscription("TMF620", id)
+	sub.Owner = "VATES-11111111K"
+	if resp := s.GetHubSubscription(newReq("GET", "READ", "TMF620", "", id, nil, nil)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("get: expected 403, got %d", resp.StatusCode)
+	}
+	if resp := update(map[string]any{"status": "active"}); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("resume: expected 403, got %d", resp.StatusCode)
+	}
+}
+
+func TestMetricsRequireToken(t *testing.T) {
+	s := newTestService(t)
+
+	if resp := s.CheckMetricsAccess(&Request{}); resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected metrics disabled by default")
+	}
+
+	s.SetMetricsToken("secret")
+	if resp := s.CheckMetricsAccess(&Request{AccessToken: "other"}); resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for an invalid token")
+	}
+	if resp := s.CheckMetricsAccess(&Request{AccessToken: "secret"}); resp != nil {
+		t.Fatalf("expected access with the token, got %d", resp.StatusCode)
+	}
 }
 
 func TestCreateGenericObjectPublishesEvent(t *testing.T) {
@@ -220,3 +316,164 @@ func TestCRUDAndListGenericObject(t *testing.T) {
 		t.Fatalf("get after delete expected 404, got %d", gResp2.StatusCode)
 	}
 }
+
+func TestCreateGenericObjectValidatesSchema(t *testing.T) {
+	s := newTestService(t)
+	specs, err := openapi.LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+	s.SetOpenAPI(specs, openapi.ModeEnforce)
+
+	resourceName := "productOffering"
+	b, _ := json.Marshal(map[string]any{"name": "Offering", "lifecycleStatus": "Launched", "isBundle": "no"})
+	resp := s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	apiErr := resp.Body.(*ApiError)
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Pointer != "/isBundle" {
+		t.Fatalf("unexpected error details: %+v", apiErr.Details)
+	}
+
+	// The same object is valid after fixing the problem, including the properties set by the server
+	b, _ = json.Marshal(map[string]any{"name": "Offering", "lifecycleStatus": "Launched", "isBundle": false})
+	resp = s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", resourceName, "", b, nil))
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	id := resp.Body.(map[string]any)["id"].(string)
+
+	// Patches are validated too
+	b, _ = json.Marshal(map[string]any{"version": "2.0", "validFor": map[string]any{"endDateTime": "never"}})
+	resp = s.UpdateGenericObject(newReq("PATCH", "UPDATE", "productCatalogManagement", resourceName, id, b, nil))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	if apiErr := resp.Body.(*ApiError); len(apiErr.Details) != 1 || apiErr.Details[0].Pointer != "/validFor/endDateTime" {
+		t.Fatalf("unexpected error details: %+v", apiErr.Details)
+	}
+}
+
+func TestCreateGenericObjectRunsValidator(t *testing.T) {
+	s := newTestService(t)
+	s.SetValidator(NewServerValidator(), openapi.ModeEnforce)
+
+	create := func(obj map[string]any) *Response {
+		b, _ := json.Marshal(obj)
+		return s.CreateGenericObject(newReq("POST", "CREATE", "productCatalogManagement", "productOffering", "", b, nil))
+	}
+
+	// Errors reject the object
+	resp := create(map[string]any{"name": "Offering", "lifecycleStatus": "Launched"})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	if apiErr := resp.Body.(*ApiError); len(apiErr.Details) != 4 || apiErr.Details[0].Pointer != "/productOfferingPrice" {
+		t.Fatalf("unexpected error details: %+v", apiErr.Details)
+	}
+
+	// Warnings are reported in a header
+	resp = create(map[string]any{"name": "Offering", "relatedParty": []any{map[string]any{"partyOrPartyRole": map[string]any{"id": "urn:ngsi-ld:individual:1"}}}})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %+v", resp.StatusCode, resp.Body)
+	}
+	if warnings := resp.Headers[ValidationWarningsHeader]; !strings.Contains(warnings, "EMPTY_ROLE") || !strings.Contains(warnings, "MISSING_PARTY_HREF") {
+		t.Fatalf("unexpected warnings: %q", warnings)
+	}
+
+	// In warn mode errors are reported as warnings, also on updates
+	s.SetValidator(NewServerValidator(), openapi.ModeWarn)
+	resp = create(map[string]any{"name": "Offering"})
+	if resp.StatusCode != http.StatusCreated || resp.Headers[ValidationWarningsHeader] != "" {
+		t.Fatalf("expected 201 without warnings, got %d %v", resp.StatusCode, resp.Headers)
+	}
+	id := resp.Body.(map[string]any)["id"].(string)
+	b, _ := json.Marshal(map[string]any{"version": "1.1", "lifecycleStatus": "Launched"})
+	resp = s.UpdateGenericObject(newReq("PATCH", "UPDATE", "productCatalogManagement", "productOffering", id, b, nil))
+	if resp.StatusCode != http.StatusOK || !strings.Contains(resp.Headers[ValidationWarningsHeader], "MISSING_FIELD_FOR_STATUS") {
+		t.Fatalf("expected 200 with warnings, got %d %v", resp.StatusCode, resp.Headers)
+	}
+}
+
+func TestCheckRoute(t *testing.T) {
+	s := newTestService(t)
+
+	testCases := []struct {
+		apiFamily, resourceName string
+		status                  int
+	}{
+		{"productCatalogManagement", "productOffering", 0},
+		{"productCatalogManagement", "", 0},
+		{"productCatalogManagement", "productOffer", http.StatusNotFound},
+		{"productCatalogManagement", "organization", http.StatusNotFound},
+		{"productCatalogMgmt", "productOffering", http.StatusNotFound},
+	}
+
+	check := func() {
+		for _, tc := range testCases {
+			resp := s.CheckRoute(tc.apiFamily, tc.resourceName)
+			if tc.status == 0 && resp != nil {
+				t.Errorf("%s/%s: expected to be accepted, got %d", tc.apiFamily, tc.resourceName, resp.StatusCode)
+			}
+			if tc.status != 0 && (resp == nil || resp.StatusCode != tc.status) {
+				t.Errorf("%s/%s: expected %d", tc.apiFamily, tc.resourceName, tc.status)
+			}
+		}
+	}
+
+	// With the mapping generated at build time
+	check()
+
+	// And with the live OpenAPI specs
+	specs, err := openapi.LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+	s.SetOpenAPI(specs, openapi.ModeOff)
+	check()
+}
+
+func TestOpenAPIDocuments(t *testing.T) {
+	s := newTestService(t)
+
+	// Without specs the index is empty, so Swagger UI can report it
+	resp := s.ListAPIs()
+	if apis, ok := resp.Body.([]APIDescription); resp.StatusCode != http.StatusOK || !ok || len(apis) != 0 {
+		t.Fatalf("unexpected index without specs: %d %v", resp.StatusCode, resp.Body)
+	}
+
+	specs, err := openapi.LoadDir("../../oapiv5")
+	if err != nil {
+		t.Fatalf("loading specs: %v", err)
+	}
+	s.SetOpenAPI(specs, openapi.ModeOff)
+
+	resp = s.ListAPIs()
+	apis, ok := resp.Body.([]APIDescription)
+	if resp.StatusCode != http.StatusOK || !ok || len(apis) != len(specs.Families()) {
+		t.Fatalf("unexpected index: %d %v", resp.StatusCode, resp.Body)
+	}
+	if apis[2].APIFamily != "productCatalogManagement" || apis[2].URL != "/tmf-api/productCatalogManagement/v5/openapi.json" {
+		t.Fatalf("unexpected API description: %+v", apis[2])
+	}
+
+	resp = s.GetOpenAPIDocument("productCatalogManagement", "https://tmf.example.com/")
+	doc, ok := resp.Body.(map[string]any)
+	if resp.StatusCode != http.StatusOK || !ok {
+		t.Fatalf("unexpected document response: %d", resp.StatusCode)
+	}
+	if url := jpath.GetString(doc, "servers.0.url"); url != "https://tmf.example.com/tmf-api/productCatalogManagement/v5" {
+		t.Fatalf("unexpected server URL %s", url)
+	}
+
+	// The spec loaded is not modified
+	spec, _ := specs.Spec("productCatalogManagement")
+	if url := jpath.GetString(spec.Doc, "servers.0.url"); url != "https://serverRoot/productCatalogManagement/v5/" {
+		t.Fatalf("spec modified: %s", url)
+	}
+
+	if resp := s.GetOpenAPIDocument("unknownManagement", "https://tmf.example.com"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown API family, got %d", resp.StatusCode)
+	}
+}
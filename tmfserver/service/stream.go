@@ -0,0 +1,124 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/hesusruiz/isbetmf/internal/errl"
+	"github.com/hesusruiz/isbetmf/tmfserver/notifications"
+)
+
+// StreamHeartbeatInterval is the interval between keep-alive comments sent on idle event streams,
+// so proxies do not close the connection.
+const StreamHeartbeatInterval = 15 * time.Second
+
+// EventStream is a stream of events of an API family for a live consumer which can not expose
+// a callback URL, like a dashboard using Server-Sent Events.
+type EventStream struct {
+	// Replay are the retained events after the last one seen by the consumer, oldest first.
+	Replay []*notifications.Event
+
+	// Live receives new events as they are published. It is closed when the stream is closed
+	// or when the consumer does not keep up with the rate of events.
+	Live <-chan *notifications.Event
+
+	cancel   func()
+	lastSeq  uint64
+	readable func(ev *notifications.Event) bool
+}
+
+// Close releases the resources of the stream. It must be called when the consumer disconnects.
+func (s *EventStream) Close() {
+	s.cancel()
+}
+
+// Accept returns true if the event has not yet been sent to the consumer and the consumer can read it,
+// and records it as sent. It is used to avoid duplicates between the replayed and the live events,
+// and must be called before sending each of them, so the PDP decides on every event as for ListEvents.
+func (s *EventStream) Accept(ev *notifications.Event) bool {
+	if ev.Seq <= s.lastSeq {
+		return false
+	}
+	s.lastSeq = ev.Seq
+	return s.readable == nil || s.readable(ev)
+}
+
+// OpenEventStream authenticates the request and opens a stream with the events of the API family.
+// The query parameters 'eventType' (comma-separated list) and 'query' (same syntax as in hub
+// subscriptions) filter the events. The 'lastEventId' parameter (set by the handlers from the
+// 'Last-Event-ID' header) resumes the stream after the given event, replaying it from the event log.
+// If the stream can not be opened, the returned Response describes the error.
+func (svc *Service) OpenEventStream(req *Request) (*EventStream, *Response) {
+	slog.Debug("OpenEventStream called", slog.String("apiFamily", req.APIfamily))
+
+	token, err := svc.extractCallerInfo(req)
+	if err != nil {
+		return nil, unauthorizedResponse(err)
+	}
+	if len(token) == 0 {
+		return nil, unauthorizedResponse(errl.Errorf("user not authenticated"))
+	}
+
+	filter, err := eventFilterFromQuery(req.QueryParams)
+	if err != nil {
+		return nil, badRequestResponse(err)
+	}
+	filter.APIFamily = req.APIfamily
+
+	query := req.QueryParams.Get("query")
+	if err := notifications.ValidateQuery(query); err != nil {
+		return nil, badRequestResponse(errl.Error(err))
+	}
+
+	var lastSeq uint64
+	if lastEventID := req.QueryParams.Get("lastEventId"); lastEventID != "" {
+		lastSeq, err = strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return nil, badRequestResponse(errl.Errorf("invalid Last-Event-ID: %s", lastEventID))
+		}
+	}
+
+	// Start listening before reading the log, so no event is lost in between.
+	// Duplicates are discarded using the sequence number.
+	live, cancel := svc.notif.Listen(filter, query)
+	stream := &EventStream{Live: live, cancel: cancel, lastSeq: lastSeq}
+	stream.readable = func(ev *notifications.Event) bool {
+		return svc.eventReadable(req, token, ev)
+	}
+
+	if lastSeq > 0 {
+		if oldest := svc.notif.Events().OldestSeq(); oldest > lastSeq+1 {
+			slog.Warn("event stream resumed after events were discarded from the log",
+				slog.Uint64("lastEventId", lastSeq), slog.Uint64("oldestRetained", oldest))
+		}
+		replayFilter := filter
+		replayFilter.AfterSeq = lastSeq
+		for _, ev := range svc.notif.Events().List(replayFilter) {
+			if notifications.MatchQuery(query, ev.Payload) {
+				stream.Replay = append(stream.Replay, ev)
+			}
+		}
+	}
+
+	slog.Info("Event stream opened", slog.String("apiFamily", req.APIfamily), slog.Int("replayed", len(stream.Replay)))
+	return stream, nil
+}
+
+// FormatSSE formats an event as a Server-Sent Event message. The SSE id is the sequence
+// number of the event, the SSE event name is the TMF event type and the data is the same
+// payload delivered to hub subscribers.
+func FormatSSE(ev *notifications.Event) ([]byte, error) {
+	data, err := json.Marshal(ev.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "id: %d\n", ev.Seq)
+	fmt.Fprintf(&b, "event: %s\n", ev.EventType)
+	fmt.Fprintf(&b, "data: %s\n\n", data)
+	return b.Bytes(), nil
+}
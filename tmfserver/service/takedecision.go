@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 
 	"github.com/hesusruiz/isbetmf/internal/errl"
@@ -85,3 +86,20 @@ func takeDecision(
 	slog.Info("PDP: request authorised")
 	return nil
 }
+
+// readRequest returns a request to read an object on behalf of the caller of req, to take the decision
+// on the objects the caller accesses indirectly, like the referenced objects or the objects in events.
+func readRequest(req *Request, apiFamily, resourceName, id string) *Request {
+	user := AuthUser{}
+	if req.AuthUser != nil {
+		user = *req.AuthUser
+	}
+	return &Request{
+		Method:       http.MethodGet,
+		Action:       HttpMethodAliases[http.MethodGet],
+		APIfamily:    apiFamily,
+		ResourceName: resourceName,
+		ID:           id,
+		AuthUser:     &user,
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hesusruiz/isbetmf/reporting"
+	"github.com/hesusruiz/isbetmf/tmfserver/openapi"
+)
+
+// ValidationWarningsHeader is the response header with the problems found by the Validator in the objects accepted
+const ValidationWarningsHeader = "X-Validation-Warnings"
+
+// maxValidationWarnings limits the number of problems reported in the header
+const maxValidationWarnings = 20
+
+// SetOpenAPI sets the OpenAPI specs of the API families implemented by the server, and what to do
+// when the body of a POST or PATCH request does not conform to the schema of the resource.
+func (svc *Service) SetOpenAPI(specs *openapi.Registry, mode openapi.Mode) {
+	svc.specs = specs
+	svc.schemaMode = mode
+}
+
+// OpenAPI returns the OpenAPI specs of the API families implemented by the server, or nil if
+// they were not loaded.
+func (svc *Service) OpenAPI() *openapi.Registry {
+	return svc.specs
+}
+
+// validateBody validates the object in the body of a create (partial is false) or update (partial is true)
+// request against the schema of the resource in the OpenAPI spec of its API family.
+// It returns a response with the problems found if the object must be rejected, or nil otherwise.
+func (svc *Service) validateBody(req *Request, obj map[string]any, partial bool) *Response {
+	if svc.specs == nil || svc.schemaMode == openapi.ModeOff || svc.schemaMode == "" {
+		return nil
+	}
+
+	var schema string
+	var problems []openapi.ValidationError
+	if partial {
+		schema, problems = svc.specs.ValidateUpdate(req.APIfamily, req.ResourceName, obj)
+	} else {
+		schema, problems = svc.specs.ValidateCreate(req.APIfamily, req.ResourceName, obj)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	// The specs which are a subset of the official ones may lack attributes of valid objects,
+	// so the objects are never rejected by them
+	mode := svc.schemaMode
+	if spec, ok := svc.specs.Spec(req.APIfamily); ok && spec.Subset {
+		mode = openapi.ModeWarn
+	}
+
+	schemaName := schema[strings.LastIndex(schema, "/")+1:]
+	details := make([]ErrorDetail, len(problems))
+	messages := make([]string, len(problems))
+	for i, p := range problems {
+		details[i] = ErrorDetail{Pointer: p.Pointer, Message: p.Message}
+		messages[i] = p.Error()
+	}
+
+	if mode == openapi.ModeWarn {
+		slog.Warn("Object does not conform to the schema", slog.String("apiFamily", req.APIfamily),
+			slog.String("resourceName", req.ResourceName), slog.String("schema", schemaName), slog.Any("problems", messages))
+		return nil
+	}
+
+	message := fmt.Sprintf("the object does not conform to the %s schema: %s", schemaName, strings.Join(messages, "; "))
+	apiErr := NewApiError("400", "Bad Request", message, fmt.Sprintf("%d", http.StatusBadRequest), "")
+	apiErr.Details = details
+	slog.Error("Object rejected by schema validation", slog.String("apiFamily", req.APIfamily),
+		slog.String("resourceName", req.ResourceName), slog.String("schema", schemaName), slog.Int("problems", len(problems)))
+	return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+}
+
+// SetValidator sets the Validator of the reporting tool, checked on the objects created or updated, and what to
+// do when it finds errors: reject the object (enforce) or accept it, reporting the errors as warnings (warn).
+func (svc *Service) SetValidator(validator *reporting.Validator, mode openapi.Mode) {
+	svc.validator = validator
+	svc.validatorMode = mode
+}
+
+// NewServerValidator returns a Validator with the rules of the reporting tool for the objects of this server
+func NewServerValidator() *reporting.Validator {
+	return reporting.NewValidator(&reporting.Config{
+		Version:                reporting.VersionV5,
+		ValidateRequiredFields: true,
+		ValidateRelatedParty:   true,
+	})
+}
+
+// validateRules checks the complete object to be stored by a create or update request with the Validator.
+// It returns a response if the object must be rejected. Otherwise, the problems found, if any, are added to the
+// headers, so the caller knows about them without waiting for the next report.
+func (svc *Service) validateRules(req *Request, obj map[string]any, headers map[string]string) *Response {
+	if svc.validator == nil || svc.validatorMode == openapi.ModeOff || svc.validatorMode == "" {
+		return nil
+	}
+
+	result := svc.validator.ValidateObject(reporting.NewTMFObjectFromMap(obj), req.ResourceName)
+
+	var warnings []string
+	if len(result.Errors) > 0 {
+		if svc.validatorMode == openapi.ModeEnforce {
+			details := make([]ErrorDetail, len(result.Errors))
+			messages := make([]string, len(result.Errors))
+			for i, e := range result.Errors {
+				details[i] = ErrorDetail{Pointer: fieldPointer(e.Field), Message: e.Message}
+				messages[i] = e.Code + " " + e.Field + ": " + e.Message
+			}
+			apiErr := NewApiError("400", "Bad Request", "the object does not pass validation: "+strings.Join(messages, "; "), fmt.Sprintf("%d", http.StatusBadRequest), "")
+			apiErr.Details = details
+			slog.Error("Object rejected by the validator", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID), slog.Int("problems", len(details)))
+			return &Response{StatusCode: http.StatusBadRequest, Body: apiErr}
+		}
+		for _, e := range result.Errors {
+			warnings = append(warnings, e.Code+" "+e.Field+": "+e.Message)
+		}
+	}
+	for _, w := range result.Warnings {
+		warnings = append(warnings, w.Code+" "+w.Field+": "+w.Message)
+	}
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	slog.Warn("Object accepted with validation problems", slog.String("resourceName", req.ResourceName), slog.String("id", req.ID), slog.Any("problems", warnings))
+	if len(warnings) > maxValidationWarnings {
+		warnings = append(warnings[:maxValidationWarnings:maxValidationWarnings], fmt.Sprintf("and %d more", len(warnings)-maxValidationWarnings))
+	}
+	headers[ValidationWarningsHeader] = headerValue(strings.Join(warnings, "; "))
+	return nil
+}
+
+// headerValue escapes the control and non-ASCII characters of a text to be sent in a response header,
+// like the values of the object quoted in the problems found, so they can not break or inject headers
+func headerValue(s string) string {
+	quoted := strconv.QuoteToASCII(s)
+	return quoted[1 : len(quoted)-1]
+}
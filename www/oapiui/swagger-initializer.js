@@ -1,19 +1,36 @@
 window.onload = function () {
    //<editor-fold desc="Changeable Configuration Block">
 
-   // the following lines will be replaced by docker/configurator, when it runs in a docker-container
-   window.ui = SwaggerUIBundle({
-      url: "/oapi/swagger/TMF620-Product_Catalog_Management-v5.0.0.oas.yaml",
-      urls: [
-         { url: "/oapi/swagger/TMF620-Product_Catalog_Management-v5.0.0.oas.yaml", name: "Product Catalog Management" },
-         { url: "/oapi/swagger/TMF632-Party_Management-v5.0.0.oas.yaml", name: "Party Management" },
-      ],
-      dom_id: "#swagger-ui",
-      deepLinking: true,
-      presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
-      plugins: [SwaggerUIBundle.plugins.DownloadUrl],
-      layout: "StandaloneLayout",
-   });
+   // The list of APIs is retrieved from the running server, which serves the OpenAPI document
+   // of each API family it implements with the server URL pointing to itself
+   const showError = (message) => {
+      document.getElementById("swagger-ui").textContent = message;
+   };
+
+   fetch("/tmf-api")
+      .then((response) => {
+         if (!response.ok) {
+            throw new Error("the server returned status " + response.status);
+         }
+         return response.json();
+      })
+      .then((apis) => {
+         if (!Array.isArray(apis) || apis.length === 0) {
+            showError("The server does not publish any OpenAPI document: start it with the -openapi-dir directory.");
+            return;
+         }
+         window.ui = SwaggerUIBundle({
+            urls: apis.map((api) => ({ url: api.url, name: api.name })),
+            dom_id: "#swagger-ui",
+            deepLinking: true,
+            presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+            plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+            layout: "StandaloneLayout",
+         });
+      })
+      .catch((error) => {
+         showError("Failed to retrieve the list of APIs from /tmf-api: " + error.message);
+      });
 
    //</editor-fold>
 };